@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"math/bits"
+	"strings"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Default minimal length of abbreviations in hex digits, same as git
+ */
+
+const DefaultAbbrevLen = 7
+
+var (
+	ErrorPrefixNotFound  = errors.New("no UUID with the prefix")
+	ErrorAmbiguousPrefix = errors.New("prefix matches several UUIDs")
+)
+
+/**
+	Computes the shortest unique hex prefixes of UUIDs in the working set like git does for commits,
+    and resolves prefixes typed in the CLI back to the full UUIDs
+
+    Safe for concurrent use, the working set is fixed at creation
+ */
+
+type Abbreviator struct {
+	trie   *Trie
+	minLen int
+}
+
+/**
+	Creates abbreviator over the working set, duplicates are ignored, minLen is the minimal abbreviation length, DefaultAbbrevLen if not positive
+ */
+
+func NewAbbreviator(ids []UUID, minLen int) *Abbreviator {
+	if minLen <= 0 {
+		minLen = DefaultAbbrevLen
+	}
+	if minLen > 32 {
+		minLen = 32
+	}
+	trie := NewTrie()
+	for _, id := range ids {
+		trie.Insert(id)
+	}
+	return &Abbreviator{trie: trie, minLen: minLen}
+}
+
+/**
+	Gets the shortest prefix of 32 hex digits not shared with other UUIDs of the working set, at least minLen long
+ */
+
+func (this *Abbreviator) Abbreviate(id UUID) string {
+	return id.Hex()[:maxInt(this.minLen, this.trie.UniquePrefixLen(id))]
+}
+
+/**
+	Gets the length making all abbreviations of the working set unique, like core.abbrev of git
+ */
+
+func (this *Abbreviator) Len() int {
+	n := this.minLen
+	this.trie.Walk("", func(id UUID) bool {
+		n = maxInt(n, this.trie.UniquePrefixLen(id))
+		return true
+	})
+	return n
+}
+
+/**
+	Resolves the prefix of hex digits in any case, hyphens are ignored
+
+    Returns ErrorPrefixNotFound or ErrorAmbiguousPrefix if the prefix does not match exactly one UUID
+ */
+
+func (this *Abbreviator) Resolve(prefix string) (UUID, error) {
+
+	if prefix == "" {
+		return Empty, errors.New("empty prefix")
+	}
+
+	var found []UUID
+	err := this.trie.Walk(prefix, func(id UUID) bool {
+		found = append(found, id)
+		return len(found) < 2
+	})
+
+	switch {
+	case err != nil:
+		return Empty, err
+	case len(found) == 0:
+		return Empty, errors.Wrapf(ErrorPrefixNotFound, "%q", prefix)
+	case len(found) > 1:
+		return Empty, errors.Wrapf(ErrorAmbiguousPrefix, "%q", prefix)
+	default:
+		return found[0], nil
+	}
+}
+
+/**
+	Parses hex prefix into the lowest UUID having it
+ */
+
+func parseHexPrefix(prefix string) (low UUID, digits int, err error) {
+	s := strings.ReplaceAll(prefix, "-", "")
+	if len(s) == 0 || len(s) > 32 {
+		return Empty, 0, errors.Errorf("invalid prefix length: %q", prefix)
+	}
+	var hi, lo uint64
+	for i := 0; i < 32; i++ {
+		var digit int8
+		if i < len(s) {
+			if digit = hexIndex[s[i]]; digit < 0 {
+				return Empty, 0, errors.Errorf("invalid hex digit %q in prefix %q", s[i], prefix)
+			}
+		}
+		hi = hi<<4 | lo>>60
+		lo = lo<<4 | uint64(digit)
+	}
+	return UUID{MostSigBits: hi, LeastSigBits: lo}, len(s), nil
+}
+
+/**
+	Gets number of leading hex digits shared by two UUIDs
+ */
+
+func commonHexPrefix(a, b UUID) int {
+	if x := a.MostSigBits ^ b.MostSigBits; x != 0 {
+		return bits.LeadingZeros64(x) / 4
+	}
+	if x := a.LeastSigBits ^ b.LeastSigBits; x != 0 {
+		return 16 + bits.LeadingZeros64(x)/4
+	}
+	return 32
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAbbreviator(t *testing.T) {
+
+	a := uuid.MustParse("534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+	b := uuid.MustParse("534b44a1-9bf1-3d20-b71e-cc4eb77c5730")
+	c := uuid.MustParse("534b9999-0000-4000-8000-000000000000")
+	d := uuid.MustParse("017f22e2-79b0-7cc3-98c4-dc0c0c07398f")
+
+	abbrev := uuid.NewAbbreviator([]uuid.UUID{c, a, d, b, a}, 0)
+
+	assert.Equal(t, "534b44a19bf13d20b71ecc4eb77c572", abbrev.Abbreviate(a))
+	assert.Equal(t, "534b44a19bf13d20b71ecc4eb77c573", abbrev.Abbreviate(b))
+	assert.Equal(t, "534b999", abbrev.Abbreviate(c))
+	assert.Equal(t, "017f22e", abbrev.Abbreviate(d))
+	assert.Equal(t, 31, abbrev.Len())
+
+	id, err := abbrev.Resolve("534B9")
+	assert.NoError(t, err)
+	assert.Equal(t, c, id)
+
+	id, err = abbrev.Resolve("017f22e2-79")
+	assert.NoError(t, err)
+	assert.Equal(t, d, id)
+
+	id, err = abbrev.Resolve(strings.ToUpper(b.String()))
+	assert.NoError(t, err)
+	assert.Equal(t, b, id)
+
+	_, err = abbrev.Resolve("534b44")
+	assert.ErrorIs(t, err, uuid.ErrorAmbiguousPrefix)
+	_, err = abbrev.Resolve("ffff")
+	assert.ErrorIs(t, err, uuid.ErrorPrefixNotFound)
+	_, err = abbrev.Resolve("0000")
+	assert.ErrorIs(t, err, uuid.ErrorPrefixNotFound)
+	_, err = abbrev.Resolve("xyz")
+	assert.Error(t, err)
+	_, err = abbrev.Resolve("")
+	assert.Error(t, err)
+
+	short := uuid.NewAbbreviator([]uuid.UUID{c, d}, 2)
+	assert.Equal(t, "53", short.Abbreviate(c))
+	assert.Equal(t, 2, short.Len())
+
+	_, err = uuid.NewAbbreviator(nil, 0).Resolve("53")
+	assert.ErrorIs(t, err, uuid.ErrorPrefixNotFound)
+}
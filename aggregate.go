@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/bits"
+)
+
+const (
+	aggregateLeaf = 0x00
+	aggregateNode = 0x01
+	aggregateSet  = 0x02
+)
+
+/**
+	Computes order-independent digest of the IDs, e.g. manifest checksum or change detection on the collection
+
+    Version 8 UUID, Empty for no IDs. Duplicates are counted, so the digest is the one of the multiset.
+ */
+
+func Aggregate(ids []UUID) UUID {
+	var a Aggregator
+	for _, id := range ids {
+		a.Add(id)
+	}
+	return a.Sum()
+}
+
+/**
+	Computes order-dependent digest of the IDs as the root of the binary Merkle tree
+
+    Version 8 UUID, Empty for no IDs. Leaves and nodes are hashed by SHA-256 with distinct prefixes,
+    odd node on the level is promoted as is.
+ */
+
+func AggregateOrdered(ids []UUID) UUID {
+
+	if len(ids) == 0 {
+		return Empty
+	}
+
+	level := make([][sha256.Size]byte, len(ids))
+	var buf [1 + 2*sha256.Size]byte
+	for i, id := range ids {
+		buf[0] = aggregateLeaf
+		id.MarshalBinaryTo(buf[1:17])
+		level[i] = sha256.Sum256(buf[:17])
+	}
+
+	for len(level) > 1 {
+		next := level[:0]
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				break
+			}
+			buf[0] = aggregateNode
+			copy(buf[1:], level[i][:])
+			copy(buf[1+sha256.Size:], level[i+1][:])
+			next = append(next, sha256.Sum256(buf[:]))
+		}
+		level = next
+	}
+
+	return digestToV8(level[0][:])
+}
+
+/**
+	Incremental order-independent digest, IDs can be added and removed without the whole collection
+
+    Sum of SHA-256 of each ID modulo 2^128, so Add and Remove commute. Zero value is ready to use.
+ */
+
+type Aggregator struct {
+	hi, lo uint64
+	count  int64
+}
+
+/**
+	Adds ID to the digest
+ */
+
+func (this *Aggregator) Add(id UUID) {
+	hi, lo := aggregateHash(id)
+	var carry uint64
+	this.lo, carry = bits.Add64(this.lo, lo, 0)
+	this.hi, _ = bits.Add64(this.hi, hi, carry)
+	this.count++
+}
+
+/**
+	Removes previously added ID from the digest
+ */
+
+func (this *Aggregator) Remove(id UUID) {
+	hi, lo := aggregateHash(id)
+	var borrow uint64
+	this.lo, borrow = bits.Sub64(this.lo, lo, 0)
+	this.hi, _ = bits.Sub64(this.hi, hi, borrow)
+	this.count--
+}
+
+/**
+	Gets number of added minus removed IDs
+ */
+
+func (this *Aggregator) Len() int64 {
+	return this.count
+}
+
+/**
+	Gets the digest, same as Aggregate of the current multiset of IDs
+ */
+
+func (this *Aggregator) Sum() UUID {
+	if this.count == 0 && this.hi == 0 && this.lo == 0 {
+		return Empty
+	}
+	var buf [25]byte
+	buf[0] = aggregateSet
+	binary.BigEndian.PutUint64(buf[1:], this.hi)
+	binary.BigEndian.PutUint64(buf[9:], this.lo)
+	binary.BigEndian.PutUint64(buf[17:], uint64(this.count))
+	sum := sha256.Sum256(buf[:])
+	return digestToV8(sum[:])
+}
+
+/**
+	Resets the digest to the empty collection
+ */
+
+func (this *Aggregator) Reset() {
+	*this = Aggregator{}
+}
+
+func aggregateHash(id UUID) (hi, lo uint64) {
+	var buf [17]byte
+	buf[0] = aggregateLeaf
+	id.MarshalBinaryTo(buf[1:])
+	sum := sha256.Sum256(buf[:])
+	return binary.BigEndian.Uint64(sum[:]), binary.BigEndian.Uint64(sum[8:])
+}
+
+func digestToV8(sum []byte) UUID {
+	var uuid UUID
+	uuid.MostSigBits = (binary.BigEndian.Uint64(sum) &^ versionMask) | v8VersionBits
+	uuid.LeastSigBits = (binary.BigEndian.Uint64(sum[8:]) & counterMask) | variantIETFBits
+	return uuid
+}
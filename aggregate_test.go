@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregate(t *testing.T) {
+
+	ids := make([]uuid.UUID, 7)
+	for i := range ids {
+		id := fixtureID()
+		ids[i] = id
+	}
+	reversed := make([]uuid.UUID, len(ids))
+	for i, id := range ids {
+		reversed[len(ids)-1-i] = id
+	}
+
+	assert.Equal(t, uuid.Empty, uuid.Aggregate(nil))
+	assert.Equal(t, uuid.Empty, uuid.AggregateOrdered(nil))
+
+	sum := uuid.Aggregate(ids)
+	assert.Equal(t, uuid.CustomVer8, sum.Version())
+	assert.Equal(t, sum, uuid.Aggregate(reversed))
+	assert.NotEqual(t, sum, uuid.Aggregate(ids[1:]))
+	assert.NotEqual(t, sum, uuid.Aggregate(append(ids[:len(ids):len(ids)], ids[0])))
+
+	ordered := uuid.AggregateOrdered(ids)
+	assert.Equal(t, uuid.CustomVer8, ordered.Version())
+	assert.NotEqual(t, ordered, uuid.AggregateOrdered(reversed))
+	assert.NotEqual(t, ordered, sum)
+	assert.Equal(t, ordered, uuid.AggregateOrdered(append([]uuid.UUID(nil), ids...)))
+	assert.NotEqual(t, uuid.AggregateOrdered(ids[:1]), uuid.Aggregate(ids[:1]))
+}
+
+func TestAggregator(t *testing.T) {
+
+	a := fixtureID()
+	b := fixtureID()
+	c := fixtureID()
+
+	var agg uuid.Aggregator
+	assert.Equal(t, uuid.Empty, agg.Sum())
+
+	agg.Add(a)
+	agg.Add(b)
+	agg.Add(c)
+	assert.Equal(t, int64(3), agg.Len())
+	assert.Equal(t, uuid.Aggregate([]uuid.UUID{c, a, b}), agg.Sum())
+
+	agg.Remove(b)
+	assert.Equal(t, uuid.Aggregate([]uuid.UUID{a, c}), agg.Sum())
+
+	agg.Remove(a)
+	agg.Remove(c)
+	assert.Equal(t, uuid.Empty, agg.Sum())
+
+	agg.Add(a)
+	agg.Reset()
+	assert.Equal(t, int64(0), agg.Len())
+}
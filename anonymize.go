@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+const anonymizeRounds = 8
+
+/**
+	Keyed pseudorandom permutation of UUIDs for sharing datasets without real identifiers
+
+    The same UUID gives the same pseudonym in every table and export anonymized with the same key,
+    so joins keep working, and different UUIDs never collide. Unlike Scrambler the round function is
+    HMAC-SHA256, so pseudonyms can not be linked to real IDs without the key.
+    Version and two top bits of the variant are kept, the remaining 122 bits are permuted by 8-round Feistel network.
+    Safe for concurrent use.
+ */
+
+type Anonymizer struct {
+	key []byte
+}
+
+/**
+	Creates anonymizer with the secret key, use distinct keys for the recipients that must not join their datasets
+ */
+
+func NewAnonymizer(key []byte) (*Anonymizer, error) {
+	if len(key) < 16 {
+		return nil, errors.New("anonymize key must have at least 16 bytes")
+	}
+	return &Anonymizer{key: append([]byte(nil), key...)}, nil
+}
+
+/**
+	Gets pseudonym of the UUID
+ */
+
+func (this *Anonymizer) Anonymize(id UUID) UUID {
+	mac := hmac.New(sha256.New, this.key)
+	l, r := scrambleSplit(id)
+	for i := 0; i < anonymizeRounds; i++ {
+		l, r = r, l^anonymizeRound(mac, i, r)
+	}
+	return scrambleJoin(id, l, r)
+}
+
+/**
+	Gets real UUID of the pseudonym
+ */
+
+func (this *Anonymizer) Deanonymize(id UUID) UUID {
+	mac := hmac.New(sha256.New, this.key)
+	l, r := scrambleSplit(id)
+	for i := anonymizeRounds - 1; i >= 0; i-- {
+		l, r = r^anonymizeRound(mac, i, l), l
+	}
+	return scrambleJoin(id, l, r)
+}
+
+/**
+	Gets pseudonyms of the UUIDs in place of the originals
+ */
+
+func (this *Anonymizer) AnonymizeAll(ids []UUID) {
+	for i, id := range ids {
+		ids[i] = this.Anonymize(id)
+	}
+}
+
+func anonymizeRound(mac hash.Hash, round int, x uint64) uint64 {
+	var data [9]byte
+	data[0] = byte(round)
+	binary.BigEndian.PutUint64(data[1:], x)
+	mac.Reset()
+	mac.Write(data[:])
+	var sum [sha256.Size]byte
+	return binary.BigEndian.Uint64(mac.Sum(sum[:0])) & scrambleHalfMask
+}
@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnonymizer(t *testing.T) {
+
+	_, err := uuid.NewAnonymizer([]byte("short"))
+	assert.Error(t, err)
+
+	a, err := uuid.NewAnonymizer([]byte("0123456789abcdef-vendor-a"))
+	assert.NoError(t, err)
+	b, err := uuid.NewAnonymizer([]byte("0123456789abcdef-vendor-b"))
+	assert.NoError(t, err)
+
+	ids := sampleIDs(t, 1000)
+	ids = append(ids, uuid.NewSHA1(uuid.NamespaceURL, []byte("x")), uuid.Empty, uuid.Max)
+
+	seen := make(map[uuid.UUID]bool)
+	for _, id := range ids {
+		p := a.Anonymize(id)
+		assert.NotEqual(t, id, p)
+		assert.Equal(t, p, a.Anonymize(id))
+		assert.NotEqual(t, p, b.Anonymize(id))
+		assert.Equal(t, id.Version(), p.Version())
+		assert.Equal(t, id.LeastSigBits>>62, p.LeastSigBits>>62)
+		assert.Equal(t, id, a.Deanonymize(p))
+		assert.False(t, seen[p])
+		seen[p] = true
+	}
+
+	table := append([]uuid.UUID(nil), ids[:3]...)
+	a.AnonymizeAll(table)
+	assert.Equal(t, a.Anonymize(ids[2]), table[2])
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIKey(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	_, err := uuid.NewAPIKeyGenerator("sk-live")
+	assert.Error(t, err)
+
+	gen, err := uuid.NewAPIKeyGenerator("sk_live_")
+	assert.NoError(t, err)
+
+	key, id, err := gen.Next()
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(key, "sk_live_"))
+	assert.Len(t, key, len("sk_live_")+22+6)
+	assert.Equal(t, uuid.RandomlyGeneratedVer4, id.Version())
+
+	actual, err := uuid.ParseAPIKey(key, "sk_live_")
+	assert.NoError(t, err)
+	assert.True(t, id.Equal(actual))
+
+	_, err = uuid.ParseAPIKey(key, "sk_test_")
+	assert.Equal(t, uuid.ErrorInvalidAPIKey, err)
+
+	typo := []byte(key)
+	if typo[10] == 'a' {
+		typo[10] = 'b'
+	} else {
+		typo[10] = 'a'
+	}
+	_, err = uuid.ParseAPIKey(string(typo), "sk_live_")
+	assert.Equal(t, uuid.ErrorInvalidAPIKeyChecksum, err)
+
+	id, _ = uuid.Parse("534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+	assert.Equal(t, "sk_"+id.Base62(), uuid.FormatAPIKey("sk_", id)[:25])
+
+}
+
+func TestBase62(t *testing.T) {
+
+	assert.Equal(t, "0000000000000000000000", uuid.Empty.Base62())
+	assert.Equal(t, "7n42DGM5Tflk9n8mt7Fhc7", uuid.Create(-1, -1).Base62())
+
+	id, _ := uuid.Parse("534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+	actual, err := uuid.ParseBase62(id.Base62())
+	assert.NoError(t, err)
+	assert.True(t, id.Equal(actual))
+
+	_, err = uuid.ParseBase62("zzzzzzzzzzzzzzzzzzzzzz")
+	assert.Error(t, err)
+
+}
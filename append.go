@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+/**
+	Appends 36 characters of the canonical string to the buffer
+
+    AppendText implements the encoding.TextAppender interface.
+ */
+
+func (this UUID) AppendText(dst []byte) ([]byte, error) {
+	n := len(dst)
+	dst = grow(dst, 36)
+	err := this.MarshalTextTo(dst[n:])
+	return dst, err
+}
+
+/**
+	Appends 16 bytes in big-endian order to the buffer
+
+    AppendBinary implements the encoding.BinaryAppender interface.
+ */
+
+func (this UUID) AppendBinary(dst []byte) ([]byte, error) {
+	n := len(dst)
+	dst = grow(dst, 16)
+	err := this.MarshalBinaryTo(dst[n:])
+	return dst, err
+}
+
+/**
+	Appends 16 bytes of the sortable layout to the buffer, used only for Time-based UUID
+ */
+
+func (this UUID) AppendSortableBinary(dst []byte) ([]byte, error) {
+	n := len(dst)
+	dst = grow(dst, 16)
+	if err := this.MarshalSortableBinaryTo(dst[n:]); err != nil {
+		return dst[:n], err
+	}
+	return dst, nil
+}
+
+/**
+	Stores canonical string at the beginning of the buffer of any sufficient size
+
+    Returns the number of bytes written, always 36 on success
+ */
+
+func (this UUID) PutText(dst []byte) (int, error) {
+	if err := this.MarshalTextTo(dst); err != nil {
+		return 0, err
+	}
+	return 36, nil
+}
+
+/**
+	Stores 16 bytes at the beginning of the buffer of any sufficient size
+
+    Returns the number of bytes written, always 16 on success
+ */
+
+func (this UUID) PutBinary(dst []byte) (int, error) {
+	if err := this.MarshalBinaryTo(dst); err != nil {
+		return 0, err
+	}
+	return 16, nil
+}
+
+/**
+	Stores 16 bytes of the sortable layout at the beginning of the buffer of any sufficient size
+
+    Returns the number of bytes written, always 16 on success
+ */
+
+func (this UUID) PutSortableBinary(dst []byte) (int, error) {
+	if err := this.MarshalSortableBinaryTo(dst); err != nil {
+		return 0, err
+	}
+	return 16, nil
+}
+
+func grow(dst []byte, n int) []byte {
+	if cap(dst)-len(dst) < n {
+		grown := make([]byte, len(dst), 2*cap(dst)+n)
+		copy(grown, dst)
+		dst = grown
+	}
+	return dst[:len(dst)+n]
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppend(t *testing.T) {
+
+	id, _ := uuid.Parse("534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+	bin, _ := id.MarshalBinary()
+
+	dst, err := id.AppendText([]byte("id="))
+	assert.NoError(t, err)
+	assert.Equal(t, "id=534b44a1-9bf1-3d20-b71e-cc4eb77c572f", string(dst))
+
+	dst, err = id.AppendBinary(dst[:3])
+	assert.NoError(t, err)
+	assert.Equal(t, append([]byte("id="), bin...), dst)
+
+	v1 := uuid.New(uuid.TimebasedVer1)
+	dst, err = v1.AppendSortableBinary(nil)
+	assert.NoError(t, err)
+	assert.Len(t, dst, 16)
+
+	dst, err = id.AppendSortableBinary([]byte{1})
+	assert.Equal(t, uuid.ErrorRequiredTimebasedUUID, err)
+	assert.Equal(t, []byte{1}, dst)
+
+	buf := make([]byte, 64)
+	n, err := id.PutText(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, 36, n)
+	m, err := id.PutBinary(buf[n:])
+	assert.NoError(t, err)
+	assert.Equal(t, 16, m)
+	assert.Equal(t, id.String(), string(buf[:n]))
+	assert.Equal(t, bin, buf[n:n+m])
+
+	n, err = v1.PutSortableBinary(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, 16, n)
+
+	_, err = id.PutText(buf[:35])
+	assert.Equal(t, uuid.ErrorWrongLen, err)
+
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+/**
+	Default number of UUIDs in one backing array of the Arena, 1 MiB of memory
+ */
+
+const DefaultArenaChunk = 64 * 1024
+
+/**
+	Slab allocator handing out []UUID blocks from large backing arrays
+
+    Reset makes all memory available again without returning it to GC,
+    so batch jobs can reuse the same arrays for every batch.
+
+    Arena is not safe for concurrent use.
+ */
+
+type Arena struct {
+	chunkSize int
+	chunks    [][]UUID
+	current   int
+	offset    int
+}
+
+/**
+	Creates arena with backing arrays of the specific number of UUIDs, DefaultArenaChunk if not positive
+ */
+
+func NewArena(chunkSize int) *Arena {
+	if chunkSize <= 0 {
+		chunkSize = DefaultArenaChunk
+	}
+	return &Arena{chunkSize: chunkSize}
+}
+
+/**
+	Allocates zeroed block of n UUIDs
+
+    Appending to the block never overwrites neighbour blocks, because its capacity is limited to n.
+    Blocks larger than the chunk size are allocated separately and are not reused.
+ */
+
+func (this *Arena) Alloc(n int) []UUID {
+
+	if n <= 0 {
+		return nil
+	}
+
+	if n > this.chunkSize {
+		return make([]UUID, n)
+	}
+
+	for this.current < len(this.chunks) && this.offset+n > this.chunkSize {
+		this.current++
+		this.offset = 0
+	}
+
+	if this.current == len(this.chunks) {
+		this.chunks = append(this.chunks, make([]UUID, this.chunkSize))
+		this.offset = 0
+	}
+
+	chunk := this.chunks[this.current]
+	block := chunk[this.offset : this.offset+n : this.offset+n]
+	this.offset += n
+
+	for i := range block {
+		block[i] = Empty
+	}
+	return block
+}
+
+/**
+	Makes all backing arrays available for allocation again
+
+    Blocks allocated before Reset must not be used after it
+ */
+
+func (this *Arena) Reset() {
+	this.current = 0
+	this.offset = 0
+}
+
+/**
+	Gets the number of UUIDs in all backing arrays
+ */
+
+func (this *Arena) Cap() int {
+	return len(this.chunks) * this.chunkSize
+}
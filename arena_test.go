@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArena(t *testing.T) {
+
+	arena := uuid.NewArena(10)
+	assert.Nil(t, arena.Alloc(0))
+
+	a := arena.Alloc(6)
+	b := arena.Alloc(4)
+	assert.Len(t, a, 6)
+	assert.Len(t, b, 4)
+	assert.Equal(t, 10, arena.Cap())
+
+	a[0] = uuid.Create(1, 1)
+	b[0] = uuid.Create(2, 2)
+	a = append(a, uuid.Create(3, 3))
+	assert.Equal(t, uuid.Create(2, 2), b[0])
+
+	c := arena.Alloc(5)
+	assert.Len(t, c, 5)
+	assert.Equal(t, 20, arena.Cap())
+
+	large := arena.Alloc(100)
+	assert.Len(t, large, 100)
+	assert.Equal(t, 20, arena.Cap())
+
+	arena.Reset()
+	d := arena.Alloc(10)
+	assert.Equal(t, uuid.Empty, d[0])
+	assert.Equal(t, 20, arena.Cap())
+
+	arena.Alloc(10)
+	assert.Equal(t, 20, arena.Cap())
+
+	assert.Len(t, uuid.NewArena(0).Alloc(uuid.DefaultArenaChunk), uuid.DefaultArenaChunk)
+
+}
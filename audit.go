@@ -0,0 +1,201 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"sync"
+	"time"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+var ErrorAuditorClosed = errors.New("auditor is closed")
+
+/**
+	Max number of records passed to AuditSink at once
+ */
+
+const auditBatch = 256
+
+/**
+	Audit record of the minted ID
+ */
+
+type AuditRecord struct {
+	ID      UUID
+	Version Version
+	Time    time.Time
+
+	/**
+		Caller label given to the Audit middleware, e.g. service or endpoint
+	 */
+
+	Label string
+}
+
+/**
+	Destination of the audit records, e.g. append-only compliance log
+ */
+
+type AuditSink interface {
+	WriteAudit(records []AuditRecord) error
+}
+
+/**
+	Buffers audit records and writes them to the sink in batches from the background goroutine
+
+    Record blocks while the buffer is full, so no ID is handed out faster than it is audited.
+    The auditor fails closed: after the sink fails, Record returns the first sink error, so the Audit
+    middleware stops handing out IDs. Records queued before the failure are still passed to the sink.
+ */
+
+type AsyncAuditor struct {
+	sink    AuditSink
+	records chan AuditRecord
+	done    chan struct{}
+
+	closeLock sync.RWMutex
+	closed    bool
+
+	errLock  sync.Mutex
+	firstErr error
+	failed   int64
+}
+
+/**
+	Creates auditor with the buffer of records and starts its goroutine
+ */
+
+func NewAsyncAuditor(sink AuditSink, buffer int) *AsyncAuditor {
+	if buffer < 1 {
+		buffer = 1
+	}
+	this := &AsyncAuditor{
+		sink:    sink,
+		records: make(chan AuditRecord, buffer),
+		done:    make(chan struct{}),
+	}
+	go this.run()
+	return this
+}
+
+/**
+	Queues the record, fails with ErrorAuditorClosed after Close and with the first sink error after the sink failed
+ */
+
+func (this *AsyncAuditor) Record(r AuditRecord) error {
+	this.closeLock.RLock()
+	defer this.closeLock.RUnlock()
+	if this.closed {
+		return ErrorAuditorClosed
+	}
+	if err := this.Err(); err != nil {
+		return err
+	}
+	this.records <- r
+	return nil
+}
+
+/**
+	Gets the first sink error, nil while the sink has not failed
+ */
+
+func (this *AsyncAuditor) Err() error {
+	this.errLock.Lock()
+	defer this.errLock.Unlock()
+	return this.firstErr
+}
+
+/**
+	Gets number of records the sink failed to write
+ */
+
+func (this *AsyncAuditor) Failed() int64 {
+	this.errLock.Lock()
+	defer this.errLock.Unlock()
+	return this.failed
+}
+
+/**
+	Writes the buffered records, stops the goroutine and returns the first sink error
+ */
+
+func (this *AsyncAuditor) Close() error {
+	this.closeLock.Lock()
+	if !this.closed {
+		this.closed = true
+		close(this.records)
+	}
+	this.closeLock.Unlock()
+
+	<-this.done
+	return this.Err()
+}
+
+func (this *AsyncAuditor) run() {
+	defer close(this.done)
+	batch := make([]AuditRecord, 0, auditBatch)
+	for r := range this.records {
+		batch = append(batch[:0], r)
+	drain:
+		for len(batch) < auditBatch {
+			select {
+			case r, ok := <-this.records:
+				if !ok {
+					break drain
+				}
+				batch = append(batch, r)
+			default:
+				break drain
+			}
+		}
+		if err := this.sink.WriteAudit(batch); err != nil {
+			this.errLock.Lock()
+			if this.firstErr == nil {
+				this.firstErr = errors.Wrap(err, "audit sink")
+			}
+			this.failed += int64(len(batch))
+			this.errLock.Unlock()
+		}
+	}
+}
+
+/**
+	Records every UUID of the generator with the caller label, the UUID is not returned if recording fails
+
+    Only the wrapped generator is audited, install Hook as Hooks.OnMint to audit every generator of the process.
+ */
+
+func Audit(auditor *AsyncAuditor, label string) Middleware {
+	return func(next Generator) Generator {
+		return GeneratorFunc(func() (UUID, error) {
+			id, err := next.Next()
+			if err != nil {
+				return id, err
+			}
+			err = auditor.Record(AuditRecord{ID: id, Version: id.Version(), Time: time.Now(), Label: label})
+			if err != nil {
+				return Empty, err
+			}
+			return id, nil
+		})
+	}
+}
+
+/**
+	Gets Hooks.OnMint recording every UUID minted by the generators of the process with the label
+
+    The hook fails closed like Audit: generators return the error instead of the UUID once recording fails.
+    It blocks the generator, sometimes under its lock, while the buffer is full. Generators wrapped
+    by Audit of the same auditor get their UUIDs recorded twice. SetHooks replaces all callbacks,
+    so keep OnMint of GetHooks when installing other hooks.
+ */
+
+func (this *AsyncAuditor) Hook(label string) func(id UUID) error {
+	return func(id UUID) error {
+		return this.Record(AuditRecord{ID: id, Version: id.Version(), Time: time.Now(), Label: label})
+	}
+}
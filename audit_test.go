@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type memoryAuditSink struct {
+	sync.Mutex
+	records []uuid.AuditRecord
+	fail    bool
+}
+
+func (this *memoryAuditSink) WriteAudit(records []uuid.AuditRecord) error {
+	this.Lock()
+	defer this.Unlock()
+	if this.fail {
+		return errors.New("log is down")
+	}
+	this.records = append(this.records, records...)
+	return nil
+}
+
+func TestAudit(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	sink := &memoryAuditSink{}
+	auditor := uuid.NewAsyncAuditor(sink, 8)
+	g := uuid.Chain(uuid.NewRandomGenerator(), uuid.Audit(auditor, "billing"))
+
+	var ids []uuid.UUID
+	for i := 0; i < 1000; i++ {
+		id, err := g.Next()
+		assert.NoError(t, err)
+		ids = append(ids, id)
+	}
+	assert.NoError(t, auditor.Close())
+	assert.NoError(t, auditor.Close())
+
+	assert.Equal(t, 1000, len(sink.records))
+	for i, r := range sink.records {
+		assert.Equal(t, ids[i], r.ID)
+		assert.Equal(t, uuid.RandomlyGeneratedVer4, r.Version)
+		assert.Equal(t, "billing", r.Label)
+		assert.False(t, r.Time.IsZero())
+	}
+
+	_, err := g.Next()
+	assert.Equal(t, uuid.ErrorAuditorClosed, err)
+}
+
+func TestAuditSinkError(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	sink := &memoryAuditSink{fail: true}
+	auditor := uuid.NewAsyncAuditor(sink, 0)
+	g := uuid.Chain(uuid.NewRandomGenerator(), uuid.Audit(auditor, "billing"))
+
+	_, err := g.Next()
+	assert.NoError(t, err)
+	for auditor.Err() == nil {
+		time.Sleep(time.Millisecond)
+	}
+
+	// fails closed, no ID is handed out after the sink failed
+	for i := 0; i < 10; i++ {
+		id, err := g.Next()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "log is down")
+		assert.Equal(t, uuid.Empty, id)
+	}
+
+	err = auditor.Close()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "log is down")
+	assert.Equal(t, int64(1), auditor.Failed())
+}
+
+func TestAuditHook(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	sink := &memoryAuditSink{}
+	auditor := uuid.NewAsyncAuditor(sink, 8)
+	prev := uuid.SetHooks(uuid.Hooks{OnMint: auditor.Hook("process")})
+	defer uuid.SetHooks(prev)
+
+	v4, err := uuid.RandomUUID()
+	assert.NoError(t, err)
+	v7, err := uuid.NewV7()
+	assert.NoError(t, err)
+	ids, err := uuid.GenerateParallel(context.Background(), 3, 2)
+	assert.NoError(t, err)
+	assert.NoError(t, auditor.Close())
+
+	minted := append([]uuid.UUID{v4, v7}, ids...)
+	assert.Equal(t, len(minted), len(sink.records))
+	for _, id := range minted {
+		found := false
+		for _, r := range sink.records {
+			found = found || r.ID == id && r.Label == "process"
+		}
+		assert.True(t, found, "UUID %s is not audited", id)
+	}
+
+	// fails closed for the unwrapped generators
+	id, err := uuid.RandomUUID()
+	assert.Equal(t, uuid.ErrorAuditorClosed, err)
+	assert.Equal(t, uuid.Empty, id)
+	_, err = uuid.NewV7()
+	assert.Equal(t, uuid.ErrorAuditorClosed, err)
+}
@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Separation of backfilled UUIDs from the live ones
+
+	version 7: the least significant bit of rand_b is 0 in live UUIDs and 1 in backfilled ones
+	version 1: backfilled UUIDs take clock sequences from the half of the space opposite to the initial one,
+	           ClockRegressionIncrementSequence keeps live clock sequences within the initial half,
+	           leased generators take them from the backfill half of the slot, see Coordinator
+ */
+
+const (
+	backfillBit          = uint64(1)
+	backfillSequenceBand = 0x2000
+	backfillSequenceMask = 0x1FFF
+)
+
+/**
+	Generates UUID for the past timestamp, e.g. backfill of historical events into time-keyed stores
+
+    Backfilled UUIDs never collide with the live UUIDs of the generators of this package and do not affect the live sequence.
+    Version 7 UUIDs have random rand_a and rand_b besides the backfill bit, or the fraction of the millisecond
+    with PrecisionMicros and PrecisionNanos. Version 1 UUIDs of the same 100-nanos tick are distinct
+    for up to 8192 backfills by the clock sequence counter of the band, or up to the number of backfill
+    clock sequences of the slot under a lease. UUIDs in the reserved ranges are re-rolled.
+ */
+
+func (this *TimeGenerator) NextAt(t time.Time) (uuid UUID, err error) {
+
+	if err := checkFrozen(); err != nil {
+		return Empty, err
+	}
+
+	this.Lock()
+	defer this.Unlock()
+
+	h := currentHooks()
+
+	uuid, err = rerollReserved(func() (uuid UUID, err error) {
+		var randomBytes [10]byte
+		if _, err := io.ReadFull(this.reader, randomBytes[:]); err != nil {
+			h.entropyError(err)
+			return Empty, errors.Wrap(err, "read entropy")
+		}
+		random := binary.BigEndian.Uint64(randomBytes[:]) & counterMask
+
+		switch {
+		case this.version == TimebasedVer1:
+			clockSequence := (this.initialClockSequence ^ backfillSequenceBand) & backfillSequenceBand
+			clockSequence |= (this.initialClockSequence + int(this.backfillCounter&backfillSequenceMask)) & backfillSequenceMask
+			if this.lease != nil {
+				clockSequence = this.lease.backfill(this.backfillCounter)
+			}
+			uuid.SetTime(t)
+			uuid.LeastSigBits = variantIETFBits
+			uuid.SetClockSequence(clockSequence)
+			uuid.SetNode(this.node)
+			this.backfillCounter++
+
+		case this.precision != PrecisionMillis:
+			bits := this.precision.fractionBits()
+			timestamp := precisionTimestamp(t, bits)
+			fraction := (uint64(timestamp) & (1<<bits - 1)) << (20 - bits)
+			uuid.MostSigBits = (uint64(timestamp>>bits) << 16) | v7VersionBits | (fraction >> 8)
+			uuid.LeastSigBits = variantIETFBits | random | backfillBit
+			if bits > 12 {
+				uuid.LeastSigBits = variantIETFBits | (fraction&0xFF)<<54 | (random >> 8) | backfillBit
+			}
+
+		default:
+			millis := uint64(t.UnixNano()/int64(time.Millisecond)) & 0xFFFFFFFFFFFF
+			randA := uint64(binary.BigEndian.Uint16(randomBytes[8:])) & v7CounterMask
+			uuid.MostSigBits = (millis << 16) | v7VersionBits | randA
+			uuid.LeastSigBits = variantIETFBits | random | backfillBit
+		}
+		return uuid, nil
+	})
+	if err != nil {
+		return Empty, err
+	}
+
+	if err := h.generated(uuid); err != nil {
+		return Empty, err
+	}
+	return uuid, nil
+}
+
+/**
+	Tells whether version 7 UUID was minted by NextAt or NewV7At, false for other versions
+
+    Meaningful only for UUIDs minted by the generators of this package, which keep the backfill bit clear
+    in live UUIDs. Version 7 UUIDs of other libraries have a random bit there and are reported
+    as backfilled half of the time.
+ */
+
+func IsBackfilled(id UUID) bool {
+	switch id.Version() {
+	case TimebasedVer7:
+		return id.LeastSigBits&backfillBit != 0
+	default:
+		return false
+	}
+}
+
+/**
+	Generates version 7 UUID for the past timestamp by the process-wide generator
+ */
+
+func NewV7At(t time.Time) (UUID, error) {
+	gen, err := defaultTimeGenerator(TimebasedVer7)
+	if err != nil {
+		return Empty, err
+	}
+	return gen.NextAt(t)
+}
+
+/**
+	Generates version 1 UUID for the past timestamp by the process-wide generator
+ */
+
+func NewV1At(t time.Time) (UUID, error) {
+	gen, err := defaultTimeGenerator(TimebasedVer1)
+	if err != nil {
+		return Empty, err
+	}
+	return gen.NextAt(t)
+}
@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"os"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewV7At(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	at := time.Date(2019, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	seen := make(map[uuid.UUID]bool)
+	for i := 0; i < 1000; i++ {
+		id, err := uuid.NewV7At(at)
+		assert.NoError(t, err)
+		assert.Equal(t, uuid.TimebasedVer7, id.Version())
+		assert.Equal(t, at.UnixNano()/int64(time.Millisecond), id.UnixTimeMillis())
+		assert.True(t, uuid.IsBackfilled(id))
+		assert.False(t, seen[id])
+		seen[id] = true
+	}
+
+	for _, precision := range []uuid.TimestampPrecision{uuid.PrecisionMillis, uuid.PrecisionMicros, uuid.PrecisionNanos} {
+		g, err := uuid.NewTimeGenerator(uuid.TimebasedVer7)
+		assert.NoError(t, err)
+		assert.NoError(t, g.SetPrecision(precision))
+
+		live, err := g.Next()
+		assert.NoError(t, err)
+		assert.False(t, uuid.IsBackfilled(live))
+
+		past, err := g.NextAt(at)
+		assert.NoError(t, err)
+		assert.True(t, uuid.IsBackfilled(past))
+		assert.Equal(t, at.UnixNano()/int64(time.Millisecond), past.UnixTimeMillis())
+
+		next, err := g.Next()
+		assert.NoError(t, err)
+		assert.True(t, uuid.ComparePostgres(live, next) < 0)
+		assert.False(t, uuid.IsBackfilled(next))
+	}
+
+	group, err := uuid.NewTimeGenerator(uuid.TimebasedVer7)
+	assert.NoError(t, err)
+	ids, err := group.NextGroup(64)
+	assert.NoError(t, err)
+	for _, id := range ids {
+		assert.False(t, uuid.IsBackfilled(id))
+	}
+}
+
+func TestNewV1At(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	at := time.Date(2019, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	g, err := uuid.NewTimeGenerator(uuid.TimebasedVer1)
+	assert.NoError(t, err)
+	live, err := g.Next()
+	assert.NoError(t, err)
+
+	seen := make(map[uuid.UUID]bool)
+	for i := 0; i < 100; i++ {
+		id, err := g.NextAt(at)
+		assert.NoError(t, err)
+		assert.Equal(t, uuid.TimebasedVer1, id.Version())
+		assert.Equal(t, at, id.Time().UTC())
+		assert.Equal(t, live.Node(), id.Node())
+		assert.NotEqual(t, live.ClockSequence()&0x2000, id.ClockSequence()&0x2000)
+		assert.False(t, uuid.IsBackfilled(id))
+		assert.False(t, seen[id])
+		seen[id] = true
+	}
+
+	id, err := uuid.NewV1At(at)
+	assert.NoError(t, err)
+	assert.Equal(t, at, id.Time().UTC())
+}
+
+func TestNextAtLeased(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	if runtime.GOOS == "windows" || runtime.GOOS == "plan9" || runtime.GOOS == "js" {
+		t.Skip("advisory file locks are not supported")
+	}
+
+	dir, err := os.MkdirTemp("", "uuid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	at := time.Date(2019, 3, 1, 12, 0, 0, 0, time.UTC)
+	clock := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	coordinator := uuid.NewCoordinator(dir, uuid.DefaultCoordinatorSlots)
+
+	var gens []*uuid.TimeGenerator
+	for i := 0; i < 2; i++ {
+		lease, err := coordinator.Acquire()
+		assert.NoError(t, err)
+		defer lease.Release()
+		gen, err := uuid.NewTimeGenerator(uuid.TimebasedVer1)
+		assert.NoError(t, err)
+		gen.SetNode(0x001122334455)
+		gen.SetClock(func() time.Time { return clock })
+		gen.SetClockRegressionPolicy(uuid.ClockRegressionIncrementSequence)
+		lease.Apply(gen)
+		gens = append(gens, gen)
+	}
+
+	backfilled := make(map[uuid.UUID]bool)
+	sequences := make(map[int]bool)
+	for i := 0; i < 32; i++ {
+		for _, gen := range gens {
+			id, err := gen.NextAt(at)
+			assert.NoError(t, err)
+			assert.Equal(t, at, id.Time().UTC())
+			assert.False(t, backfilled[id], "duplicate backfilled UUID %s", id)
+			backfilled[id] = true
+			sequences[id.ClockSequence()] = true
+		}
+	}
+
+	for i := 0; i < 64; i++ {
+		clock = clock.Add(-time.Second)
+		for _, gen := range gens {
+			id, err := gen.Next()
+			assert.NoError(t, err)
+			assert.False(t, sequences[id.ClockSequence()], "live clock sequence %d is kept for backfill", id.ClockSequence())
+		}
+	}
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import "github.com/codeallergy/uuid/internal/errors"
+
+/**
+	Encodes UUID as the value of BigQuery STRING column
+
+    Elements of bigquery.Value rows of cloud.google.com/go/bigquery are plain interface values,
+    so ValueSaver and ValueLoader of the row type use the codecs without the dependency in this module:
+
+	func (r *Order) Save() (map[string]bigquery.Value, string, error) {
+		return map[string]bigquery.Value{"id": r.ID.EncodeBigQuery(), "total": r.Total}, r.ID.String(), nil
+	}
+
+	func (r *Order) Load(v []bigquery.Value, s bigquery.Schema) error {
+		r.Total, _ = v[1].(float64)
+		return r.ID.DecodeBigQuery(v[0])
+	}
+ */
+
+func (this UUID) EncodeBigQuery() interface{} {
+	return this.String()
+}
+
+/**
+	Decodes UUID from the value of BigQuery STRING or BYTES column, NULL fails with ErrorNullUUID
+ */
+
+func (this *UUID) DecodeBigQuery(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		return ErrorNullUUID
+	case string, []byte:
+		return this.Scan(v)
+	default:
+		return errors.Errorf("unsupported BigQuery UUID source type %T", value)
+	}
+}
+
+/**
+	Encodes UUID as the value of BigQuery BYTES column, 16 bytes
+ */
+
+func (this BlobUUID) EncodeBigQuery() interface{} {
+	data, _ := UUID(this).MarshalBinary()
+	return data
+}
+
+/**
+	Decodes UUID from the value of BigQuery STRING or BYTES column
+ */
+
+func (this *BlobUUID) DecodeBigQuery(value interface{}) error {
+	return (*UUID)(this).DecodeBigQuery(value)
+}
+
+/**
+	Encodes UUID as the value of nullable BigQuery STRING column, nil if not valid
+ */
+
+func (this NullUUID) EncodeBigQuery() interface{} {
+	if !this.Valid {
+		return nil
+	}
+	return this.UUID.EncodeBigQuery()
+}
+
+/**
+	Decodes UUID from the value of nullable BigQuery STRING or BYTES column
+ */
+
+func (this *NullUUID) DecodeBigQuery(value interface{}) error {
+	if value == nil {
+		this.UUID, this.Valid = Empty, false
+		return nil
+	}
+	if err := this.UUID.DecodeBigQuery(value); err != nil {
+		this.Valid = false
+		return err
+	}
+	this.Valid = true
+	return nil
+}
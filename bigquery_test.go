@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+/**
+	Same shape as bigquery.Value of cloud.google.com/go/bigquery
+ */
+
+type bigqueryValue interface{}
+
+type bigqueryOrder struct {
+	ID     uuid.UUID
+	Parent uuid.NullUUID
+}
+
+func (r *bigqueryOrder) Save() (map[string]bigqueryValue, string, error) {
+	return map[string]bigqueryValue{"id": r.ID.EncodeBigQuery(), "parent": r.Parent.EncodeBigQuery()}, r.ID.String(), nil
+}
+
+func (r *bigqueryOrder) Load(v []bigqueryValue) error {
+	if err := r.ID.DecodeBigQuery(v[0]); err != nil {
+		return err
+	}
+	return r.Parent.DecodeBigQuery(v[1])
+}
+
+func TestBigQuery(t *testing.T) {
+
+	id, _ := uuid.Parse("534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+	blob, _ := id.MarshalBinary()
+
+	order := &bigqueryOrder{ID: id}
+	row, insertID, err := order.Save()
+	assert.NoError(t, err)
+	assert.Equal(t, id.String(), insertID)
+	assert.Equal(t, id.String(), row["id"])
+	assert.Nil(t, row["parent"])
+
+	var loaded bigqueryOrder
+	assert.NoError(t, loaded.Load([]bigqueryValue{row["id"], row["parent"]}))
+	assert.Equal(t, id, loaded.ID)
+	assert.False(t, loaded.Parent.Valid)
+
+	assert.NoError(t, loaded.Load([]bigqueryValue{blob, id.String()}))
+	assert.Equal(t, id, loaded.ID)
+	assert.True(t, loaded.Parent.Valid)
+	assert.Equal(t, id, loaded.Parent.UUID)
+
+	assert.Equal(t, uuid.ErrorNullUUID, loaded.Load([]bigqueryValue{nil, nil}))
+	assert.Error(t, loaded.Load([]bigqueryValue{int64(42), nil}))
+
+	assert.Equal(t, blob, uuid.BlobUUID(id).EncodeBigQuery())
+	var scanned uuid.BlobUUID
+	assert.NoError(t, scanned.DecodeBigQuery(blob))
+	assert.Equal(t, id, uuid.UUID(scanned))
+}
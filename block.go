@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"encoding/binary"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Compressed block of UUIDs sorted by ComparePostgres
+
+	byte 0:  format version, 1
+	uvarint: number of UUIDs
+	per UUID: uvarint delta of MostSigBits from the previous UUID,
+	          then uvarint delta of LeastSigBits if MostSigBits are equal, otherwise 8 bytes of LeastSigBits
+
+    Time-based version 7 UUIDs share the timestamp prefix, so dense runs take about 10 bytes per UUID
+    instead of 16. Random bits of the least significant half are stored as is.
+ */
+
+const sortedBlockVersion = 1
+
+var (
+	ErrorNotSorted  = errors.New("UUIDs are not sorted")
+	ErrorWrongBlock = errors.New("malformed sorted block")
+)
+
+/**
+	Appends compressed block of the UUIDs sorted by ComparePostgres to dst, duplicates are preserved
+ */
+
+func AppendSortedBlock(dst []byte, ids []UUID) ([]byte, error) {
+
+	dst = append(dst, sortedBlockVersion)
+	dst = appendUvarint(dst, uint64(len(ids)))
+
+	var prev UUID
+	for i, id := range ids {
+		if i > 0 && ComparePostgres(prev, id) > 0 {
+			return nil, errors.Wrapf(ErrorNotSorted, "at index %d", i)
+		}
+		delta := id.MostSigBits - prev.MostSigBits
+		dst = appendUvarint(dst, delta)
+		if delta == 0 && i > 0 {
+			dst = appendUvarint(dst, id.LeastSigBits-prev.LeastSigBits)
+		} else {
+			dst = appendUint64(dst, id.LeastSigBits)
+		}
+		prev = id
+	}
+	return dst, nil
+}
+
+/**
+	Decodes compressed block produced by AppendSortedBlock
+ */
+
+func DecodeSortedBlock(data []byte) ([]UUID, error) {
+
+	if len(data) == 0 || data[0] != sortedBlockVersion {
+		return nil, errors.Wrap(ErrorWrongBlock, "unknown format version")
+	}
+	data = data[1:]
+
+	count, n := binary.Uvarint(data)
+	if n <= 0 {
+		return nil, errors.Wrap(ErrorWrongBlock, "count")
+	}
+	data = data[n:]
+	// every UUID takes at least two bytes
+	if count > uint64(len(data))/2 {
+		return nil, errors.Wrap(ErrorWrongBlock, "count exceeds data")
+	}
+
+	ids := make([]UUID, count)
+	var prev UUID
+	for i := range ids {
+		delta, n := binary.Uvarint(data)
+		if n <= 0 {
+			return nil, errors.Wrapf(ErrorWrongBlock, "UUID %d", i)
+		}
+		data = data[n:]
+
+		var id UUID
+		id.MostSigBits = prev.MostSigBits + delta
+		if delta == 0 && i > 0 {
+			lsbDelta, n := binary.Uvarint(data)
+			if n <= 0 {
+				return nil, errors.Wrapf(ErrorWrongBlock, "UUID %d", i)
+			}
+			data = data[n:]
+			id.LeastSigBits = prev.LeastSigBits + lsbDelta
+		} else {
+			if len(data) < 8 {
+				return nil, errors.Wrapf(ErrorWrongBlock, "UUID %d", i)
+			}
+			id.LeastSigBits = binary.BigEndian.Uint64(data)
+			data = data[8:]
+		}
+		ids[i] = id
+		prev = id
+	}
+
+	if len(data) != 0 {
+		return nil, errors.Wrap(ErrorWrongBlock, "trailing bytes")
+	}
+	return ids, nil
+}
+
+func appendUvarint(dst []byte, x uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], x)
+	return append(dst, buf[:n]...)
+}
+
+func appendUint64(dst []byte, x uint64) []byte {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], x)
+	return append(dst, buf[:]...)
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortedBlock(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	gen, err := uuid.NewTimeGenerator(uuid.TimebasedVer7)
+	assert.NoError(t, err)
+	now := time.Now()
+	gen.SetClock(func() time.Time { return now })
+
+	ids := make([]uuid.UUID, 10000)
+	for i := range ids {
+		if i%100 == 0 {
+			now = now.Add(time.Millisecond)
+		}
+		ids[i], err = gen.Next()
+		assert.NoError(t, err)
+	}
+	ids[5000] = ids[4999]
+	ids[6000].LeastSigBits = ids[5999].LeastSigBits + 1
+	ids[6000].MostSigBits = ids[5999].MostSigBits
+
+	block, err := uuid.AppendSortedBlock([]byte("hdr"), ids)
+	assert.NoError(t, err)
+	assert.Equal(t, "hdr", string(block[:3]))
+	assert.True(t, len(block) < len(ids)*11, "size %d", len(block))
+
+	decoded, err := uuid.DecodeSortedBlock(block[3:])
+	assert.NoError(t, err)
+	assert.Equal(t, ids, decoded)
+
+	empty, err := uuid.AppendSortedBlock(nil, nil)
+	assert.NoError(t, err)
+	decoded, err = uuid.DecodeSortedBlock(empty)
+	assert.NoError(t, err)
+	assert.Empty(t, decoded)
+
+	_, err = uuid.AppendSortedBlock(nil, []uuid.UUID{ids[1], ids[0]})
+	assert.ErrorIs(t, err, uuid.ErrorNotSorted)
+
+	for _, bad := range [][]byte{nil, {2, 0}, {1}, {1, 200}, block[3 : len(block)-1], append(block[3:len(block):len(block)], 0)} {
+		_, err = uuid.DecodeSortedBlock(bad)
+		assert.ErrorIs(t, err, uuid.ErrorWrongBlock)
+	}
+}
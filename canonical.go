@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+/**
+	Parses any supported string form and returns canonical lowercase hyphenated string
+
+    Accepts every form of ParseAny in any case: canonical, braced, quoted, urn:uuid:, 32 hex digits,
+    standard base64 with padding, URL-safe base64 without padding, base58, ULID and C initializer of GUID struct.
+    Surrounding whitespace is ignored.
+ */
+
+func Canonicalize(s string) (string, error) {
+	id, _, err := ParseAny(s)
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
+
+/**
+	Compares underlying 128 bits of two strings in any form accepted by ParseAny
+
+    Returns false if any of them can not be parsed
+ */
+
+func EqualString(a, b string) bool {
+	left, _, err := ParseAny(a)
+	if err != nil {
+		return false
+	}
+	right, _, err := ParseAny(b)
+	if err != nil {
+		return false
+	}
+	return left.Equal(right)
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanonicalize(t *testing.T) {
+
+	const canonical = "534b44a1-9bf1-3d20-b71e-cc4eb77c572f"
+
+	for _, s := range []string{
+		canonical,
+		"534B44A1-9BF1-3D20-B71E-CC4EB77C572F",
+		"{534b44a1-9bf1-3d20-b71e-cc4eb77c572f}",
+		"urn:uuid:534b44a1-9bf1-3d20-b71e-cc4eb77c572f",
+		"URN:UUID:534B44A1-9BF1-3D20-B71E-CC4EB77C572F",
+		"534b44a19bf13d20b71ecc4eb77c572f",
+		"U0tEoZvxPSC3HsxOt3xXLw==",
+		"U0tEoZvxPSC3HsxOt3xXLw",
+		"  534b44a1-9bf1-3d20-b71e-cc4eb77c572f\n",
+		`"534b44a1-9bf1-3d20-b71e-cc4eb77c572f"`,
+		"{ 0x534B44A1, 0x9BF1, 0x3D20, { 0xB7, 0x1E, 0xCC, 0x4E, 0xB7, 0x7C, 0x57, 0x2F } }",
+		uuid.MustParse(canonical).Base58(),
+		uuid.MustParse(canonical).ULID(),
+	} {
+		actual, err := uuid.Canonicalize(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, canonical, actual, s)
+	}
+
+	// base58 of Empty and low values is shorter, one '1' for every leading zero byte
+	for s, expected := range map[string]string{
+		uuid.Empty.Base58():                     "00000000-0000-0000-0000-000000000000",
+		uuid.UUID{LeastSigBits: 1}.Base58():     "00000000-0000-0000-0000-000000000001",
+		uuid.UUID{MostSigBits: 0x1234}.Base58(): "00000000-0000-1234-0000-000000000000",
+	} {
+		actual, err := uuid.Canonicalize(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, expected, actual, s)
+	}
+
+	for _, s := range []string{"", "abc", "zzzzzzzz-9bf1-3d20-b71e-cc4eb77c572f", "534b44a19bf13d20b71ecc4eb77c572z"} {
+		_, err := uuid.Canonicalize(s)
+		assert.Error(t, err, s)
+	}
+
+}
+
+func TestEqualString(t *testing.T) {
+
+	assert.True(t, uuid.EqualString("534b44a1-9bf1-3d20-b71e-cc4eb77c572f", "{534B44A1-9BF1-3D20-B71E-CC4EB77C572F}"))
+	assert.True(t, uuid.EqualString("urn:uuid:534b44a1-9bf1-3d20-b71e-cc4eb77c572f", "U0tEoZvxPSC3HsxOt3xXLw"))
+	assert.True(t, uuid.EqualString("534b44a19bf13d20b71ecc4eb77c572f", "U0tEoZvxPSC3HsxOt3xXLw=="))
+
+	id := uuid.MustParse("534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+	forms := []string{id.String(), "{" + id.String() + "}", "urn:uuid:" + id.String(), id.Base64(), id.Base64URL(), id.Base58(), id.ULID(), id.GUIDInitializer()}
+	for _, a := range forms {
+		for _, b := range forms {
+			assert.True(t, uuid.EqualString(a, b), "%s %s", a, b)
+		}
+		assert.False(t, uuid.EqualString(a, uuid.Empty.String()), a)
+	}
+
+	assert.True(t, uuid.EqualString(uuid.Empty.Base58(), uuid.Empty.String()))
+	low := uuid.UUID{LeastSigBits: 58}
+	assert.True(t, uuid.EqualString(low.Base58(), low.String()))
+	assert.False(t, uuid.EqualString(low.Base58(), uuid.Empty.Base58()))
+
+	assert.False(t, uuid.EqualString("534b44a1-9bf1-3d20-b71e-cc4eb77c572f", "534b44a1-9bf1-3d20-b71e-cc4eb77c5720"))
+	assert.False(t, uuid.EqualString("garbage", "garbage"))
+	assert.False(t, uuid.EqualString("534b44a1-9bf1-3d20-b71e-cc4eb77c572f", ""))
+
+}
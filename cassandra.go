@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import "time"
+
+const (
+	cassandraMinClockSeqAndNode = uint64(0x8080808080808080)
+	cassandraMaxClockSeqAndNode = uint64(0x7f7f7f7f7f7f7f7f)
+	cassandraSignedBytes        = uint64(0x8080808080808080)
+)
+
+/**
+	Gets the smallest Time-based UUID of the millisecond, same as CQL minTimeuuid()
+
+    Clock sequence and node are 0x8080808080808080, the smallest value for Cassandra signed byte comparison
+ */
+
+func MinTimeUUID(t time.Time) UUID {
+	uuid := UUID{LeastSigBits: cassandraMinClockSeqAndNode}
+	uuid.SetUnixTimeMillis(unixMillis(t))
+	return uuid
+}
+
+/**
+	Gets the greatest Time-based UUID of the millisecond, same as CQL maxTimeuuid()
+
+    Clock sequence and node are 0x7f7f7f7f7f7f7f7f, the greatest value for Cassandra signed byte comparison
+ */
+
+func MaxTimeUUID(t time.Time) UUID {
+	uuid := UUID{LeastSigBits: cassandraMaxClockSeqAndNode}
+	uuid.SetUnixTime100Nanos((unixMillis(t)+1)*one100NanosInMillis - 1)
+	return uuid
+}
+
+/**
+	Compares Time-based UUIDs like Cassandra timeuuid type
+
+    Timestamps are compared first, then clock sequence and node bytes as signed values
+ */
+
+func CompareCassandraTimeUUID(a, b UUID) int {
+	if c := compareUint64(a.Time100NanosUnsigned(), b.Time100NanosUnsigned()); c != 0 {
+		return c
+	}
+	return compareUint64(a.LeastSigBits^cassandraSignedBytes, b.LeastSigBits^cassandraSignedBytes)
+}
+
+func unixMillis(t time.Time) int64 {
+	millis := t.Unix() * 1000
+	return millis + int64(t.Nanosecond())/int64(time.Millisecond)
+}
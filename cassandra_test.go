@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCassandraTimeUUID(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	ts := time.Unix(1700000000, 123456789)
+
+	min := uuid.MinTimeUUID(ts)
+	max := uuid.MaxTimeUUID(ts)
+
+	assert.Equal(t, uuid.TimebasedVer1, min.Version())
+	assert.Equal(t, uuid.TimebasedVer1, max.Version())
+	assert.Equal(t, int64(1700000000123), min.UnixTimeMillis())
+	assert.Equal(t, int64(1700000000123), max.UnixTimeMillis())
+	assert.Equal(t, uint64(0x8080808080808080), min.LeastSigBits)
+	assert.Equal(t, uint64(0x7f7f7f7f7f7f7f7f), max.LeastSigBits)
+	assert.Equal(t, min.Time100Nanos()+9999, max.Time100Nanos())
+
+	// known value from Cassandra: minTimeuuid('1970-01-01 00:00:00+0000')
+	assert.Equal(t, "13814000-1dd2-11b2-8080-808080808080", uuid.MinTimeUUID(time.Unix(0, 0)).String())
+
+	gen, _ := uuid.NewTimeGenerator(uuid.TimebasedVer1)
+	for i := 0; i < 100; i++ {
+		id, _ := gen.Next()
+		bucket := time.Unix(0, id.UnixTimeMillis()*int64(time.Millisecond))
+		assert.Equal(t, -1, uuid.CompareCassandraTimeUUID(uuid.MinTimeUUID(bucket), id))
+		assert.Equal(t, 1, uuid.CompareCassandraTimeUUID(uuid.MaxTimeUUID(bucket), id))
+	}
+
+	assert.Equal(t, -1, uuid.CompareCassandraTimeUUID(max, uuid.MinTimeUUID(ts.Add(time.Millisecond))))
+	assert.Equal(t, 0, uuid.CompareCassandraTimeUUID(min, min))
+
+}
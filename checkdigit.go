@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"strings"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+const (
+	crc4Poly = 0x3 // x^4 + x + 1
+
+	hexDigits = "0123456789abcdef"
+)
+
+var ErrorInvalidCheckDigit = errors.New("invalid check digit")
+
+/**
+	Gets canonical string with the appended check digit, e.g. 534b44a1-9bf1-3d20-b71e-cc4eb77c572f-9
+
+    Check digit is CRC-4 over 128 bits, so any single mistyped hex digit and any swap of the adjacent
+    hex digits are always detected. Both errors flip bits within 8 consecutive bits as d(x)(x^4+1)x^k
+    with d(x) of degree below 4, the irreducible x^4+x+1 divides none of the factors. Damm would detect
+    the same errors and change the check digits of the stored strings.
+ */
+
+func (this UUID) StringWithCheckDigit() string {
+	return this.String() + "-" + string(hexDigits[this.checkDigit()])
+}
+
+/**
+	Parses string produced by StringWithCheckDigit, case insensitive
+ */
+
+func ParseWithCheckDigit(s string) (UUID, error) {
+
+	if len(s) != 36+2 || s[36] != '-' {
+		return Empty, errors.Errorf("invalid UUID with check digit format: %q", s)
+	}
+
+	id, err := Parse(s[:36])
+	if err != nil {
+		return Empty, err
+	}
+
+	if strings.ToLower(s[37:]) != string(hexDigits[id.checkDigit()]) {
+		return Empty, ErrorInvalidCheckDigit
+	}
+
+	return id, nil
+}
+
+func (this UUID) checkDigit() int {
+	crc := 0
+	for _, word := range [2]uint64{this.MostSigBits, this.LeastSigBits} {
+		for i := 63; i >= 0; i-- {
+			top := (crc>>3)&1 ^ int(word>>uint(i))&1
+			crc = (crc << 1) & 0xF
+			if top != 0 {
+				crc ^= crc4Poly
+			}
+		}
+	}
+	return crc
+}
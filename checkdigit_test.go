@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckDigit(t *testing.T) {
+
+	id, _ := uuid.Parse("534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+
+	s := id.StringWithCheckDigit()
+	assert.Equal(t, "534b44a1-9bf1-3d20-b71e-cc4eb77c572f-9", s)
+	assert.True(t, strings.HasPrefix(s, id.String()+"-"))
+
+	actual, err := uuid.ParseWithCheckDigit(strings.ToUpper(s))
+	assert.NoError(t, err)
+	assert.True(t, id.Equal(actual))
+
+	// every single mistyped hex digit is detected
+	for i := 0; i < 36; i++ {
+		if s[i] == '-' {
+			continue
+		}
+		for _, c := range "0123456789abcdef" {
+			if byte(c) == s[i] {
+				continue
+			}
+			typo := s[:i] + string(c) + s[i+1:]
+			_, err := uuid.ParseWithCheckDigit(typo)
+			assert.Equal(t, uuid.ErrorInvalidCheckDigit, err, typo)
+		}
+	}
+
+	// every swap of the adjacent hex digits is detected, hyphens are skipped
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 200; n++ {
+		id := uuid.Create(int64(r.Uint64()), int64(r.Uint64()))
+		s := id.StringWithCheckDigit()
+		digits := strings.Replace(s[:36], "-", "", -1)
+		for i := 0; i+1 < len(digits); i++ {
+			if digits[i] == digits[i+1] {
+				continue
+			}
+			swapped := digits[:i] + string(digits[i+1]) + string(digits[i]) + digits[i+2:]
+			typo := swapped[:8] + "-" + swapped[8:12] + "-" + swapped[12:16] + "-" + swapped[16:20] + "-" + swapped[20:] + s[36:]
+			_, err := uuid.ParseWithCheckDigit(typo)
+			assert.Equal(t, uuid.ErrorInvalidCheckDigit, err, typo)
+		}
+	}
+
+	_, err = uuid.ParseWithCheckDigit(id.String())
+	assert.Error(t, err)
+
+}
@@ -0,0 +1,159 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+/**
+	Max number of invalid values kept by Census as samples
+ */
+
+const MaxCensusSamples = 10
+
+/**
+	Counts UUIDs by version
+ */
+
+func Classify(ids []UUID) map[Version]int {
+	versions := make(map[Version]int)
+	for _, id := range ids {
+		versions[id.Version()]++
+	}
+	return versions
+}
+
+/**
+	Summary of the kinds of IDs in the column, e.g. data audit before the migration
+
+    Invalid are IDs other than Nil and Max that are not IETF variant of versions 1 to 8,
+    malformed are strings that do not parse. Not safe for concurrent use.
+ */
+
+type Census struct {
+	Total     int
+	Versions  map[Version]int
+	Variants  map[Variant]int
+	Nil       int
+	Max       int
+	Invalid   int
+	Malformed int
+
+	/**
+		First MaxCensusSamples invalid IDs and malformed strings
+	 */
+
+	Samples []string
+}
+
+/**
+	Creates empty census
+ */
+
+func NewCensus() *Census {
+	return &Census{Versions: make(map[Version]int), Variants: make(map[Variant]int)}
+}
+
+/**
+	Counts census of the IDs
+ */
+
+func ClassifyAll(ids []UUID) *Census {
+	c := NewCensus()
+	for _, id := range ids {
+		c.Add(id)
+	}
+	return c
+}
+
+/**
+	Counts ID
+ */
+
+func (this *Census) Add(id UUID) {
+	this.Total++
+	switch id {
+	case Empty:
+		this.Nil++
+		return
+	case Max:
+		this.Max++
+		return
+	}
+	version, variant := id.Version(), id.Variant()
+	this.Versions[version]++
+	this.Variants[variant]++
+	if variant != IETF || version == BadVersion || version == UnknownVersion {
+		this.Invalid++
+		this.sample(id.String())
+	}
+}
+
+/**
+	Counts string value of the column, parsed by Parse
+ */
+
+func (this *Census) AddString(s string) {
+	id, err := Parse(s)
+	if err != nil {
+		this.Total++
+		this.Malformed++
+		this.sample(s)
+		return
+	}
+	this.Add(id)
+}
+
+/**
+	Tells whether all IDs are valid or Nil
+ */
+
+func (this *Census) OK() bool {
+	return this.Invalid == 0 && this.Malformed == 0 && this.Max == 0
+}
+
+func (this *Census) sample(s string) {
+	if len(this.Samples) < MaxCensusSamples {
+		this.Samples = append(this.Samples, s)
+	}
+}
+
+/**
+	Gets multi-line report
+ */
+
+func (this *Census) String() string {
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "total: %d\n", this.Total)
+
+	versions := make([]int, 0, len(this.Versions))
+	for version := range this.Versions {
+		versions = append(versions, int(version))
+	}
+	sort.Ints(versions)
+	for _, version := range versions {
+		fmt.Fprintf(&out, "version %d: %d\n", version, this.Versions[Version(version)])
+	}
+
+	variants := make([]int, 0, len(this.Variants))
+	for variant := range this.Variants {
+		variants = append(variants, int(variant))
+	}
+	sort.Ints(variants)
+	for _, variant := range variants {
+		fmt.Fprintf(&out, "variant %v: %d\n", Variant(variant), this.Variants[Variant(variant)])
+	}
+
+	fmt.Fprintf(&out, "nil: %d\nmax: %d\ninvalid: %d\nmalformed: %d\n", this.Nil, this.Max, this.Invalid, this.Malformed)
+	for _, s := range this.Samples {
+		fmt.Fprintf(&out, "sample: %q\n", s)
+	}
+	return out.String()
+}
@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassify(t *testing.T) {
+
+	v4 := fixtureID()
+	v5 := uuid.NewSHA1(uuid.NamespaceURL, []byte("x"))
+	ncs := uuid.UUID{MostSigBits: 0x4000, LeastSigBits: 1}
+
+	versions := uuid.Classify([]uuid.UUID{v4, v4, v5, uuid.Empty})
+	assert.Equal(t, map[uuid.Version]int{uuid.RandomlyGeneratedVer4: 2, uuid.NamebasedVer5: 1, uuid.BadVersion: 1}, versions)
+
+	c := uuid.ClassifyAll([]uuid.UUID{v4, v5, uuid.Empty, uuid.Max, ncs})
+	assert.Equal(t, 5, c.Total)
+	assert.Equal(t, 1, c.Nil)
+	assert.Equal(t, 1, c.Max)
+	assert.Equal(t, 1, c.Invalid)
+	assert.Equal(t, 2, c.Versions[uuid.RandomlyGeneratedVer4])
+	assert.Equal(t, 2, c.Variants[uuid.IETF])
+	assert.Equal(t, 1, c.Variants[uuid.NCSReserved])
+	assert.Equal(t, []string{ncs.String()}, c.Samples)
+	assert.False(t, c.OK())
+
+	c = uuid.NewCensus()
+	c.AddString(v4.String())
+	assert.True(t, c.OK())
+	for i := 0; i < 20; i++ {
+		c.AddString("not-a-uuid")
+	}
+	assert.Equal(t, 21, c.Total)
+	assert.Equal(t, 20, c.Malformed)
+	assert.Equal(t, uuid.MaxCensusSamples, len(c.Samples))
+	assert.True(t, strings.Contains(c.String(), "malformed: 20\n"))
+	assert.True(t, strings.Contains(c.String(), "version 4: 1\n"))
+}
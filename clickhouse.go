@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Stores UUID in to 16 bytes of ClickHouse native layout
+
+    ClickHouse keeps UUID as two little-endian 64-bit halves: most significant bits first, then least significant bits
+ */
+
+func (this UUID) MarshalClickHouse() []byte {
+	dst := make([]byte, 16)
+	this.MarshalClickHouseTo(dst)
+	return dst
+}
+
+/**
+	Stores UUID in to the slice in ClickHouse native layout
+ */
+
+func (this UUID) MarshalClickHouseTo(dst []byte) error {
+
+	if len(dst) < 16 {
+		return ErrorWrongLen
+	}
+
+	binary.LittleEndian.PutUint64(dst, this.MostSigBits)
+	binary.LittleEndian.PutUint64(dst[8:], this.LeastSigBits)
+	return nil
+}
+
+/**
+	Convert 16 bytes of ClickHouse native layout to UUID
+ */
+
+func (this *UUID) UnmarshalClickHouse(data []byte) error {
+
+	if len(data) < 16 {
+		return ErrorWrongLen
+	}
+
+	this.MostSigBits = binary.LittleEndian.Uint64(data)
+	this.LeastSigBits = binary.LittleEndian.Uint64(data[8:])
+	return nil
+}
+
+/**
+	UUID bound to ClickHouse columns in native byte order
+
+    Use it as clickhouse-go argument or scan target for UUID and FixedString(16) columns
+    to avoid byte-swapped identifiers, e.g. rows.Scan((*uuid.ClickHouseUUID)(&id))
+ */
+
+type ClickHouseUUID UUID
+
+/**
+	Value implements the driver.Valuer interface.
+ */
+
+func (this ClickHouseUUID) Value() (driver.Value, error) {
+	return UUID(this).MarshalClickHouse(), nil
+}
+
+/**
+	Scan implements the sql.Scanner interface, accepts 16 bytes in native layout or canonical string
+ */
+
+func (this *ClickHouseUUID) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case []byte:
+		if len(value) != 16 {
+			return ErrorWrongLen
+		}
+		return (*UUID)(this).UnmarshalClickHouse(value)
+	case string:
+		id, err := Parse(value)
+		*this = ClickHouseUUID(id)
+		return err
+	default:
+		return errors.Errorf("unsupported ClickHouse UUID source type %T", src)
+	}
+}
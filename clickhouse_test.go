@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClickHouse(t *testing.T) {
+
+	id, _ := uuid.Parse("534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+
+	data := id.MarshalClickHouse()
+	assert.Equal(t, []byte{0x20, 0x3d, 0xf1, 0x9b, 0xa1, 0x44, 0x4b, 0x53, 0x2f, 0x57, 0x7c, 0xb7, 0x4e, 0xcc, 0x1e, 0xb7}, data)
+
+	var actual uuid.UUID
+	assert.NoError(t, actual.UnmarshalClickHouse(data))
+	assert.True(t, id.Equal(actual))
+
+	value, err := uuid.ClickHouseUUID(id).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, data, value)
+
+	var scanned uuid.ClickHouseUUID
+	assert.NoError(t, scanned.Scan(data))
+	assert.True(t, id.Equal(uuid.UUID(scanned)))
+
+	assert.NoError(t, scanned.Scan(id.String()))
+	assert.True(t, id.Equal(uuid.UUID(scanned)))
+
+	assert.Error(t, scanned.Scan(42))
+	assert.Equal(t, uuid.ErrorWrongLen, scanned.Scan(data[:8]))
+
+}
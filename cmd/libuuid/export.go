@@ -0,0 +1,60 @@
+//go:build cgo
+// +build cgo
+
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package main
+
+// #include <stdint.h>
+import "C"
+
+import (
+	"unsafe"
+)
+
+func bytes16(p *C.uint8_t) []byte {
+	return (*[16]byte)(unsafe.Pointer(p))[:]
+}
+
+//export uuid_generate_v7
+func uuid_generate_v7(out *C.uint8_t) C.int {
+	if out == nil {
+		return -1
+	}
+	return C.int(generateV7(bytes16(out)))
+}
+
+//export uuid_generate_v4
+func uuid_generate_v4(out *C.uint8_t) C.int {
+	if out == nil {
+		return -1
+	}
+	return C.int(generateV4(bytes16(out)))
+}
+
+//export uuid_parse
+func uuid_parse(s *C.char, out *C.uint8_t) C.int {
+	if s == nil || out == nil {
+		return -1
+	}
+	return C.int(parse(C.GoString(s), bytes16(out)))
+}
+
+//export uuid_format
+func uuid_format(in *C.uint8_t, out *C.char) C.int {
+	if in == nil || out == nil {
+		return -1
+	}
+	return C.int(format(bytes16(in), (*[37]byte)(unsafe.Pointer(out))[:]))
+}
+
+//export uuid_version
+func uuid_version(in *C.uint8_t) C.int {
+	if in == nil {
+		return -1
+	}
+	return C.int(version(bytes16(in)))
+}
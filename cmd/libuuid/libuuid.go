@@ -0,0 +1,103 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+/**
+	C ABI of the uuid package for non-Go components linking the same generation logic and layouts
+
+    Build:
+	go build -buildmode=c-shared -o libuuid.so ./cmd/libuuid
+	go build -buildmode=c-archive -o libuuid.a ./cmd/libuuid
+
+    The generated libuuid.h declares the functions, UUIDs are 16 bytes in the RFC byte order,
+    functions return 0 on success and -1 on error. Exports need cgo, without cgo the package builds
+    to an empty program.
+ */
+
+package main
+
+import (
+	"sync"
+
+	"github.com/codeallergy/uuid"
+)
+
+var (
+	generatorV7Once  sync.Once
+	generatorV7      *uuid.TimeGenerator
+	generatorV7Error error
+)
+
+/**
+	Creates v7 generator on the first call, the entropy error is returned to the C caller as -1
+ */
+
+func timeGenerator() (*uuid.TimeGenerator, error) {
+	generatorV7Once.Do(func() {
+		generatorV7, generatorV7Error = uuid.NewTimeGenerator(uuid.TimebasedVer7)
+	})
+	return generatorV7, generatorV7Error
+}
+
+func main() {
+}
+
+func generateV7(out []byte) int {
+	gen, err := timeGenerator()
+	if err != nil {
+		return -1
+	}
+	id, err := gen.Next()
+	if err != nil {
+		return -1
+	}
+	return marshal(id, out)
+}
+
+func generateV4(out []byte) int {
+	id, err := uuid.NewRandomGenerator().Next()
+	if err != nil {
+		return -1
+	}
+	return marshal(id, out)
+}
+
+func parse(s string, out []byte) int {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return -1
+	}
+	return marshal(id, out)
+}
+
+/**
+	Writes canonical form and the NUL terminator, out must have 37 bytes
+ */
+
+func format(in []byte, out []byte) int {
+	var id uuid.UUID
+	if err := id.UnmarshalBinary(in); err != nil {
+		return -1
+	}
+	if err := id.MarshalTextTo(out[:36]); err != nil {
+		return -1
+	}
+	out[36] = 0
+	return 0
+}
+
+func version(in []byte) int {
+	var id uuid.UUID
+	if err := id.UnmarshalBinary(in); err != nil {
+		return -1
+	}
+	return int(id.Version())
+}
+
+func marshal(id uuid.UUID, out []byte) int {
+	if err := id.MarshalBinaryTo(out); err != nil {
+		return -1
+	}
+	return 0
+}
@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package main
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLibrary(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	var id [16]byte
+	assert.Equal(t, 0, generateV7(id[:]))
+	assert.Equal(t, int(uuid.TimebasedVer7), version(id[:]))
+
+	var text [37]byte
+	assert.Equal(t, 0, format(id[:], text[:]))
+	assert.Equal(t, byte(0), text[36])
+
+	var parsed [16]byte
+	assert.Equal(t, 0, parse(string(text[:36]), parsed[:]))
+	assert.Equal(t, id, parsed)
+
+	assert.Equal(t, 0, generateV4(id[:]))
+	assert.Equal(t, int(uuid.RandomlyGeneratedVer4), version(id[:]))
+
+	assert.Equal(t, -1, parse("not-a-uuid", parsed[:]))
+	assert.Equal(t, -1, format(id[:8], text[:]))
+}
@@ -0,0 +1,162 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package main
+
+import (
+	"bufio"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/codeallergy/uuid"
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+type format struct {
+	encode func(uuid.UUID) (string, error)
+	decode func(string) (uuid.UUID, error)
+}
+
+var formats = map[string]format{
+	"canonical": {
+		func(id uuid.UUID) (string, error) { return id.String(), nil },
+		uuid.Parse,
+	},
+	"hex": {
+		func(id uuid.UUID) (string, error) { return id.Hex(), nil },
+		uuid.ParseHex,
+	},
+	"base64": {
+		func(id uuid.UUID) (string, error) { return id.Base64(), nil },
+		uuid.ParseBase64,
+	},
+	"base64url": {
+		func(id uuid.UUID) (string, error) { return id.Base64URL(), nil },
+		uuid.ParseBase64URL,
+	},
+	"base58": {
+		func(id uuid.UUID) (string, error) { return id.Base58(), nil },
+		uuid.ParseBase58,
+	},
+	"ulid": {
+		func(id uuid.UUID) (string, error) { return id.ULID(), nil },
+		uuid.ParseULID,
+	},
+	"sortable-binary-hex": {
+		encodeSortableHex,
+		decodeSortableHex,
+	},
+}
+
+func encodeSortableHex(id uuid.UUID) (string, error) {
+	data, err := id.MarshalSortableBinary()
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(data), nil
+}
+
+func decodeSortableHex(s string) (id uuid.UUID, err error) {
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		return uuid.Empty, err
+	}
+	if len(data) != 16 {
+		return uuid.Empty, uuid.ErrorWrongLen
+	}
+	err = id.UnmarshalSortableBinary(data)
+	return id, err
+}
+
+func formatNames() string {
+	var names []string
+	for name := range formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+func lookupFormat(name string) (format, error) {
+	f, ok := formats[name]
+	if !ok {
+		return f, errors.Errorf("unknown format %q, supported: %s", name, formatNames())
+	}
+	return f, nil
+}
+
+func runConvert(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+
+	fs := flag.NewFlagSet("convert", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	from := fs.String("from", "canonical", "input format: "+formatNames())
+	to := fs.String("to", "canonical", "output format: "+formatNames())
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return 2
+	}
+
+	src, err := lookupFormat(*from)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	dst, err := lookupFormat(*to)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	out := bufio.NewWriter(stdout)
+	defer out.Flush()
+
+	failed := false
+	convert := func(line int, s string) {
+		var encoded string
+		id, err := src.decode(s)
+		if err == nil {
+			encoded, err = dst.encode(id)
+		}
+		if err != nil {
+			failed = true
+			fmt.Fprintf(stderr, "line %d: %q: %v\n", line, s, err)
+			return
+		}
+		out.WriteString(encoded)
+		out.WriteByte('\n')
+	}
+
+	if len(positional) > 0 {
+		for i, s := range positional {
+			convert(i+1, s)
+		}
+	} else {
+		scanner := bufio.NewScanner(stdin)
+		line := 0
+		for scanner.Scan() {
+			line++
+			s := strings.TrimSpace(scanner.Text())
+			if s == "" {
+				continue
+			}
+			convert(line, s)
+		}
+		if err := scanner.Err(); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+	}
+
+	if failed {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,29 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package main
+
+import "flag"
+
+/**
+	Parses flags mixed with positional arguments, e.g. "inspect <id> --json"
+ */
+
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+
+	var positional []string
+
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
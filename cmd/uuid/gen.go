@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"text/template"
+
+	"github.com/codeallergy/uuid"
+)
+
+const defaultGenFormat = "{{.Canonical}}"
+
+/**
+	Template data of the generated UUID, all fields are computed lazily
+ */
+
+type record struct {
+	id uuid.UUID
+}
+
+func (r *record) Canonical() string    { return r.id.String() }
+func (r *record) URN() string          { return r.id.URN() }
+func (r *record) Hex() string          { return r.id.Hex() }
+func (r *record) Base64() string       { return r.id.Base64() }
+func (r *record) Base64URL() string    { return r.id.Base64URL() }
+func (r *record) Base58() string       { return r.id.Base58() }
+func (r *record) ULID() string         { return r.id.ULID() }
+func (r *record) Version() int         { return int(r.id.Version()) }
+func (r *record) UnixMillis() int64    { return r.id.UnixTimeMillis() }
+func (r *record) MostSigBits() string  { return strconv.FormatUint(r.id.MostSigBits, 10) }
+func (r *record) LeastSigBits() string { return strconv.FormatUint(r.id.LeastSigBits, 10) }
+
+func runGen(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+
+	fs := flag.NewFlagSet("gen", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	v1 := fs.Bool("v1", false, "generate time-based version 1")
+	v4 := fs.Bool("v4", false, "generate random version 4 (default)")
+	v7 := fs.Bool("v7", false, "generate time-ordered version 7")
+	n := fs.Int("n", 1, "number of UUIDs")
+	format := fs.String("format", defaultGenFormat, "text/template applied to each UUID, e.g. '{{.Canonical}},{{.UnixMillis}}'")
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return 2
+	}
+	if len(positional) > 0 {
+		fmt.Fprintf(stderr, "unexpected arguments: %v\n", positional)
+		return 2
+	}
+
+	version := uuid.RandomlyGeneratedVer4
+	selected := 0
+	if *v1 {
+		version = uuid.TimebasedVer1
+		selected++
+	}
+	if *v4 {
+		version = uuid.RandomlyGeneratedVer4
+		selected++
+	}
+	if *v7 {
+		version = uuid.TimebasedVer7
+		selected++
+	}
+	if selected > 1 {
+		fmt.Fprintln(stderr, "only one of -v1, -v4, -v7 is allowed")
+		return 2
+	}
+
+	gen, err := uuid.NewGenerator(version)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	var tmpl *template.Template
+	if *format != defaultGenFormat {
+		tmpl, err = template.New("format").Parse(*format)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 2
+		}
+	}
+
+	out := bufio.NewWriterSize(stdout, 64*1024)
+	defer out.Flush()
+
+	var buf [37]byte
+	buf[36] = '\n'
+	rec := &record{}
+
+	for i := 0; i < *n; i++ {
+
+		rec.id, err = gen.Next()
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+
+		if tmpl == nil {
+			rec.id.MarshalTextTo(buf[:36])
+			if _, err := out.Write(buf[:]); err != nil {
+				fmt.Fprintln(stderr, err)
+				return 1
+			}
+			continue
+		}
+
+		if err := tmpl.Execute(out, rec); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		out.WriteByte('\n')
+	}
+
+	return 0
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/codeallergy/uuid"
+)
+
+func runInspect(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+
+	fs := flag.NewFlagSet("inspect", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	asJSON := fs.Bool("json", false, "print result as JSON")
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return 2
+	}
+
+	if len(positional) != 1 {
+		fmt.Fprintln(stderr, "usage: uuid inspect <id> [--json]")
+		return 2
+	}
+
+	id, err := uuid.Parse(positional[0])
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	info := id.Inspect()
+
+	if *asJSON {
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(info); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		return 0
+	}
+
+	fmt.Fprintf(stdout, "id:             %s\n", info.ID)
+	if info.Name != "" {
+		fmt.Fprintf(stdout, "name:           %s\n", info.Name)
+	}
+	fmt.Fprintf(stdout, "version:        %s\n", info.Version)
+	fmt.Fprintf(stdout, "variant:        %s\n", info.Variant)
+	if info.Timestamp != "" {
+		fmt.Fprintf(stdout, "timestamp:      %s\n", info.Timestamp)
+	}
+	if info.Node != "" {
+		fmt.Fprintf(stdout, "node:           %s\n", info.Node)
+	}
+	if info.ClockSequence != nil {
+		fmt.Fprintf(stdout, "clock sequence: %d\n", *info.ClockSequence)
+	}
+	fmt.Fprintf(stdout, "urn:            %s\n", info.Encodings.URN)
+	fmt.Fprintf(stdout, "hex:            %s\n", info.Encodings.Hex)
+	fmt.Fprintf(stdout, "base64:         %s\n", info.Encodings.Base64)
+	fmt.Fprintf(stdout, "base64url:      %s\n", info.Encodings.Base64URL)
+	fmt.Fprintf(stdout, "base58:         %s\n", info.Encodings.Base58)
+	fmt.Fprintf(stdout, "ulid:           %s\n", info.Encodings.ULID)
+	if info.Encodings.SortableBinaryHex != "" {
+		fmt.Fprintf(stdout, "sortable hex:   %s\n", info.Encodings.SortableBinaryHex)
+	}
+	return 0
+}
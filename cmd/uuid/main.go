@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+/**
+	Command line tool to decode, convert and generate UUIDs
+
+    Usage:
+
+	uuid inspect <id> [--json]
+	uuid convert [--from canonical] [--to canonical] [ids...]
+	uuid gen [-v1|-v4|-v7] [-n 1] [--format '{{.Canonical}}']
+	uuid validate [--version 0] [--strict] < ids.txt
+	uuid rekey [--key hex] < id,created.csv > old,new.csv
+ */
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+type command struct {
+	name  string
+	usage string
+	run   func(args []string, stdin io.Reader, stdout, stderr io.Writer) int
+}
+
+var commands = []command{
+	{"inspect", "inspect <id> [--json]", runInspect},
+	{"convert", "convert [--from canonical] [--to canonical] [ids...]", runConvert},
+	{"gen", "gen [-v1|-v4|-v7] [-n 1] [--format '{{.Canonical}}']", runGen},
+	{"validate", "validate [--version 0] [--strict] < ids.txt", runValidate},
+	{"rekey", "rekey [--key hex] < id,created.csv > old,new.csv", runRekey},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+
+	if len(args) == 0 {
+		usage(stderr)
+		return 2
+	}
+
+	for _, cmd := range commands {
+		if cmd.name == args[0] {
+			return cmd.run(args[1:], stdin, stdout, stderr)
+		}
+	}
+
+	fmt.Fprintf(stderr, "unknown command: %q\n", args[0])
+	usage(stderr)
+	return 2
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "\tuuid %s\n", cmd.usage)
+	}
+}
@@ -0,0 +1,174 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func runCommand(stdin string, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	code := run(args, strings.NewReader(stdin), &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestUnknownCommand(t *testing.T) {
+
+	code, _, stderr := runCommand("", "unknown")
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, "Usage:")
+
+}
+
+func TestInspect(t *testing.T) {
+
+	code, stdout, _ := runCommand("", "inspect", "534b44a1-9bf1-3d20-b71e-cc4eb77c572f", "--json")
+	assert.Equal(t, 0, code)
+
+	var info uuid.Info
+	assert.NoError(t, json.Unmarshal([]byte(stdout), &info))
+	assert.Equal(t, "NamebasedVer3", info.Version)
+	assert.Equal(t, "534b44a19bf13d20b71ecc4eb77c572f", info.Encodings.Hex)
+
+	code, stdout, _ = runCommand("", "inspect", "534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stdout, "version:        NamebasedVer3")
+	for _, line := range []string{"base64url:      " + info.Encodings.Base64URL, "base58:         " + info.Encodings.Base58, "ulid:           " + info.Encodings.ULID} {
+		assert.Contains(t, stdout, line+"\n")
+	}
+
+	code, stdout, _ = runCommand("", "inspect", "1ec9414c-232a-6b00-b3c8-9f6bdeced846")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stdout, "timestamp:      2022-02-22T19:22:22Z")
+
+	code, stdout, _ = runCommand("", "inspect", "6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stdout, "name:           NamespaceDNS")
+
+	code, _, stderr := runCommand("", "inspect", "not-an-id")
+	assert.Equal(t, 1, code)
+	assert.NotEmpty(t, stderr)
+
+}
+
+func TestConvert(t *testing.T) {
+
+	code, stdout, _ := runCommand("534b44a19bf13d20b71ecc4eb77c572f\n\n", "convert", "--from", "hex", "--to", "base64url")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "U0tEoZvxPSC3HsxOt3xXLw\n", stdout)
+
+	code, stdout, _ = runCommand("", "convert", "--to", "ulid", "534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "2K9D2A36ZH7MGBE7PC9TVQRNSF\n", stdout)
+
+	code, stdout, stderr := runCommand("bad\n534b44a1-9bf1-3d20-b71e-cc4eb77c572f\n", "convert", "--to", "base58")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "line 1")
+	assert.Equal(t, 1, strings.Count(stdout, "\n"))
+
+	code, _, _ = runCommand("", "convert", "--to", "sortable-binary-hex", "534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+	assert.Equal(t, 1, code)
+
+	v1 := "c232ab00-9414-11ec-b3c8-9e6bdeced846"
+	code, stdout, _ = runCommand("", "convert", "--to", "sortable-binary-hex", v1)
+	assert.Equal(t, 0, code)
+	code, back, _ := runCommand("", "convert", "--from", "sortable-binary-hex", strings.TrimSpace(stdout))
+	assert.Equal(t, 0, code)
+	assert.Equal(t, v1+"\n", back)
+
+	code, _, _ = runCommand("", "convert", "--to", "unknown")
+	assert.Equal(t, 2, code)
+
+}
+
+func TestGen(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	code, stdout, _ := runCommand("", "gen", "-v7", "-n", "100")
+	assert.Equal(t, 0, code)
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	assert.Len(t, lines, 100)
+	for _, line := range lines {
+		id, err := uuid.Parse(line)
+		assert.NoError(t, err)
+		assert.Equal(t, uuid.TimebasedVer7, id.Version())
+	}
+
+	code, stdout, _ = runCommand("", "gen", "-v1", "-n", "3", "--format", "{{.Hex}},{{.Version}}")
+	assert.Equal(t, 0, code)
+	lines = strings.Split(strings.TrimSpace(stdout), "\n")
+	assert.Len(t, lines, 3)
+	assert.True(t, strings.HasSuffix(lines[0], ",1"))
+	assert.Len(t, lines[0], 34)
+
+	code, _, _ = runCommand("", "gen", "-v1", "-v7")
+	assert.Equal(t, 2, code)
+
+}
+
+func TestValidate(t *testing.T) {
+
+	input := "534B44A1-9BF1-3D20-B71E-CC4EB77C572F\n\n{534b44a1-9bf1-3d20-b71e-cc4eb77c572f}\n"
+	code, stdout, stderr := runCommand(input, "validate")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "534b44a1-9bf1-3d20-b71e-cc4eb77c572f\n534b44a1-9bf1-3d20-b71e-cc4eb77c572f\n", stdout)
+	assert.Empty(t, stderr)
+
+	input = "garbage\n534b44a1-9bf1-3d20-b71e-cc4eb77c572f\n"
+	code, stdout, stderr = runCommand(input, "validate", "--version", "4")
+	assert.Equal(t, 1, code)
+	assert.Empty(t, stdout)
+	assert.Contains(t, stderr, "line 1: \"garbage\"")
+	assert.Contains(t, stderr, "line 2: ")
+	assert.Contains(t, stderr, "expected version 4, got 3")
+
+	code, _, stderr = runCommand("00000000-0000-0000-0000-000000000000\n", "validate", "--strict")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "unsupported variant")
+
+	code, _, stderr = runCommand("534b44a19bf13d20b71ecc4eb77c57zz\n", "validate")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "line 1: ")
+
+}
+
+func TestRekey(t *testing.T) {
+
+	input := "534b44a1-9bf1-4d20-b71e-cc4eb77c572f,2021-03-04T05:06:07.008Z\n" +
+		"6ba7b810-9dad-41d1-80b4-00c04fd430c8,1614834367008\n" +
+		"broken\n"
+
+	code, stdout, stderr := runCommand(input, "rekey", "--key", "6d6967726174696f6e")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "line 3")
+
+	rekeyer, _ := uuid.NewRekeyer([]byte("migration"))
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	assert.Equal(t, 2, len(lines))
+
+	for _, line := range lines {
+		pair := strings.Split(line, ",")
+		old, err := uuid.Parse(pair[0])
+		assert.NoError(t, err)
+		id, err := uuid.Parse(pair[1])
+		assert.NoError(t, err)
+		assert.True(t, rekeyer.Verify(old, id))
+		assert.Equal(t, int64(1614834367008), id.UnixTimeMillis())
+	}
+
+	code, _, _ = runCommand("", "rekey", "--key", "zz")
+	assert.Equal(t, 2, code)
+
+}
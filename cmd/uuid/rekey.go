@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package main
+
+import (
+	"bufio"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+const rekeyKeyEnv = "UUID_REKEY_KEY"
+
+/**
+	Parses creation time as RFC 3339 or unix millis
+ */
+
+func parseCreatedAt(s string) (time.Time, error) {
+	if millis, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(millis/1000, (millis%1000)*int64(time.Millisecond)), nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return t, errors.Errorf("invalid creation time %q, expected RFC 3339 or unix millis", s)
+	}
+	return t, nil
+}
+
+func runRekey(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+
+	fs := flag.NewFlagSet("rekey", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	keyHex := fs.String("key", "", "hex secret key, "+rekeyKeyEnv+" if empty")
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return 2
+	}
+	if len(positional) > 0 {
+		fmt.Fprintf(stderr, "unexpected arguments: %v\n", positional)
+		return 2
+	}
+
+	if *keyHex == "" {
+		*keyHex = os.Getenv(rekeyKeyEnv)
+	}
+	key, err := hex.DecodeString(*keyHex)
+	if err != nil {
+		fmt.Fprintf(stderr, "invalid key: %v\n", err)
+		return 2
+	}
+	rekeyer, err := uuid.NewRekeyer(key)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	out := bufio.NewWriter(stdout)
+	defer out.Flush()
+
+	failed := 0
+	scanner := bufio.NewScanner(stdin)
+	line := 0
+
+	for scanner.Scan() {
+
+		line++
+		s := strings.TrimSpace(scanner.Text())
+		if s == "" {
+			continue
+		}
+
+		var id uuid.UUID
+		var createdAt time.Time
+		fields := strings.Split(s, ",")
+		if len(fields) != 2 {
+			err = errors.New("expected <id>,<created at>")
+		} else if id, err = uuid.Parse(strings.TrimSpace(fields[0])); err == nil {
+			createdAt, err = parseCreatedAt(strings.TrimSpace(fields[1]))
+		}
+
+		if err != nil {
+			failed++
+			fmt.Fprintf(stderr, "line %d: %q: %v\n", line, s, err)
+			continue
+		}
+
+		out.WriteString(id.String())
+		out.WriteByte(',')
+		out.WriteString(rekeyer.Rekey(id, createdAt).String())
+		out.WriteByte('\n')
+	}
+
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	if failed > 0 {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/codeallergy/uuid"
+)
+
+func runValidate(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+
+	fs := flag.NewFlagSet("validate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	version := fs.Int("version", 0, "require the specific UUID version, any version if 0")
+	strict := fs.Bool("strict", false, "require the IETF variant")
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return 2
+	}
+	if len(positional) > 0 {
+		fmt.Fprintf(stderr, "unexpected arguments: %v\n", positional)
+		return 2
+	}
+
+	out := bufio.NewWriter(stdout)
+	defer out.Flush()
+
+	failed := 0
+	scanner := bufio.NewScanner(stdin)
+	line := 0
+
+	for scanner.Scan() {
+
+		line++
+		s := strings.TrimSpace(scanner.Text())
+		if s == "" {
+			continue
+		}
+
+		id, err := uuid.Parse(s)
+		switch {
+		case err != nil:
+		case *strict && !id.Variant().Valid():
+			err = fmt.Errorf("unsupported variant %v", id.Variant())
+		case *version != 0 && id.Version() != uuid.Version(*version):
+			err = fmt.Errorf("expected version %d, got %d", *version, int(id.Version()))
+		}
+
+		if err != nil {
+			failed++
+			fmt.Fprintf(stderr, "line %d: %q: %v\n", line, s, err)
+			continue
+		}
+
+		out.WriteString(id.String())
+		out.WriteByte('\n')
+	}
+
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	if failed > 0 {
+		fmt.Fprintf(stderr, "%d of %d lines failed validation\n", failed, line)
+		return 1
+	}
+	return 0
+}
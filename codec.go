@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Returns true for binary encodings, their output is not printable
+ */
+
+func (e Encoding) Binary() bool {
+	switch e {
+	case EncodingBinaryBE, EncodingBinaryLE, EncodingSortableBinary:
+		return true
+	default:
+		return false
+	}
+}
+
+/**
+	Encodes UUID in the encoding, so the format choice can be data-driven from configuration
+
+    EncodingSortableBinary is supported only for Time-based versions 1, 6 and 7
+ */
+
+func Encode(id UUID, e Encoding) ([]byte, error) {
+	switch e {
+	case EncodingCanonical:
+		return []byte(id.String()), nil
+	case EncodingHex32:
+		return []byte(id.Hex()), nil
+	case EncodingBraced:
+		return []byte("{" + id.String() + "}"), nil
+	case EncodingURN:
+		return []byte(id.URN()), nil
+	case EncodingBase64URL:
+		return []byte(id.Base64URL()), nil
+	case EncodingBase58:
+		return []byte(id.Base58()), nil
+	case EncodingULID:
+		return []byte(id.ULID()), nil
+	case EncodingBase64:
+		return []byte(id.Base64()), nil
+	case EncodingBase62:
+		return []byte(id.Base62()), nil
+	case EncodingGUIDInitializer:
+		return []byte(id.GUIDInitializer()), nil
+	case EncodingBinaryBE:
+		return id.MarshalBinary()
+	case EncodingBinaryLE:
+		return ToLegacyMongo(id, MongoCSharpLegacy)
+	case EncodingSortableBinary:
+		return id.MarshalSortableBinaryV2()
+	default:
+		return nil, errors.Errorf("unknown encoding: %v", e)
+	}
+}
+
+/**
+	Encodes UUID in the text encoding, fails for binary ones
+ */
+
+func EncodeToString(id UUID, e Encoding) (string, error) {
+	if e.Binary() {
+		return "", errors.Errorf("binary encoding %v", e)
+	}
+	data, err := Encode(id, e)
+	return string(data), err
+}
+
+/**
+	Decodes UUID in the encoding, reverse of Encode
+ */
+
+func Decode(data []byte, e Encoding) (id UUID, err error) {
+	switch e {
+	case EncodingCanonical:
+		if len(data) != 36 {
+			return Empty, ErrorWrongLen
+		}
+		return ParseBytes(data)
+	case EncodingURN:
+		if len(data) != 45 {
+			return Empty, ErrorWrongLen
+		}
+		return ParseBytes(data)
+	case EncodingBraced:
+		if len(data) != 38 || data[0] != '{' || data[37] != '}' {
+			return Empty, errors.Errorf("invalid braced UUID: %q", data)
+		}
+		return ParseBytes(data)
+	case EncodingHex32:
+		return ParseHex(string(data))
+	case EncodingBase64URL:
+		return ParseBase64URL(string(data))
+	case EncodingBase58:
+		return ParseBase58(string(data))
+	case EncodingULID:
+		return ParseULID(string(data))
+	case EncodingBase64:
+		return ParseBase64(string(data))
+	case EncodingBase62:
+		return ParseBase62(string(data))
+	case EncodingGUIDInitializer:
+		return ParseGUIDInitializer(string(data))
+	case EncodingBinaryBE:
+		err = id.UnmarshalBinary(data)
+		return id, err
+	case EncodingBinaryLE:
+		return FromLegacyMongo(data, MongoCSharpLegacy)
+	case EncodingSortableBinary:
+		err = id.UnmarshalSortableBinaryV2(data)
+		return id, err
+	default:
+		return Empty, errors.Errorf("unknown encoding: %v", e)
+	}
+}
+
+/**
+	Decodes UUID from the string in the encoding
+ */
+
+func DecodeString(s string, e Encoding) (UUID, error) {
+	return Decode([]byte(s), e)
+}
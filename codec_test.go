@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeDecode(t *testing.T) {
+
+	id := uuid.MustParse("017f22e2-79b0-7cc3-98c4-dc0c0c07398f")
+
+	expected := map[uuid.Encoding]string{
+		uuid.EncodingCanonical:       "017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+		uuid.EncodingHex32:           "017f22e279b07cc398c4dc0c0c07398f",
+		uuid.EncodingBraced:          "{017f22e2-79b0-7cc3-98c4-dc0c0c07398f}",
+		uuid.EncodingURN:             "urn:uuid:017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+		uuid.EncodingGUIDInitializer: "{0x017f22e2,0x79b0,0x7cc3,{0x98,0xc4,0xdc,0x0c,0x0c,0x07,0x39,0x8f}}",
+		uuid.EncodingBinaryBE:        "\x01\x7f\x22\xe2\x79\xb0\x7c\xc3\x98\xc4\xdc\x0c\x0c\x07\x39\x8f",
+		uuid.EncodingBinaryLE:        "\xe2\x22\x7f\x01\xb0\x79\xc3\x7c\x98\xc4\xdc\x0c\x0c\x07\x39\x8f",
+	}
+
+	for e := uuid.EncodingCanonical; e <= uuid.EncodingSortableBinary; e++ {
+
+		data, err := uuid.Encode(id, e)
+		assert.NoError(t, err, e.String())
+		if s, ok := expected[e]; ok {
+			assert.Equal(t, s, string(data), e.String())
+		}
+
+		decoded, err := uuid.Decode(data, e)
+		assert.NoError(t, err, e.String())
+		assert.Equal(t, id, decoded, e.String())
+
+		parsed, err := uuid.ParseEncoding(e.String())
+		assert.NoError(t, err)
+		assert.Equal(t, e, parsed)
+
+		s, err := uuid.EncodeToString(id, e)
+		if e.Binary() {
+			assert.Error(t, err, e.String())
+		} else {
+			assert.NoError(t, err, e.String())
+			decoded, err = uuid.DecodeString(s, e)
+			assert.NoError(t, err)
+			assert.Equal(t, id, decoded)
+		}
+	}
+
+	_, err := uuid.DecodeString("{017f22e2-79b0-7cc3-98c4-dc0c0c07398f}", uuid.EncodingCanonical)
+	assert.Error(t, err)
+	_, err = uuid.DecodeString("(017f22e2-79b0-7cc3-98c4-dc0c0c07398f)", uuid.EncodingBraced)
+	assert.Error(t, err)
+	_, err = uuid.Encode(id, uuid.Encoding(100))
+	assert.Error(t, err)
+	_, err = uuid.Decode(nil, uuid.Encoding(100))
+	assert.Error(t, err)
+	_, err = uuid.Encode(uuid.New(uuid.RandomlyGeneratedVer4), uuid.EncodingSortableBinary)
+	assert.Error(t, err)
+}
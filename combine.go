@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+/**
+	Combines two IDs into the deterministic ID of the directed relationship, e.g. follows or parent-child edge
+
+    Version 5 UUID of the bytes of b in the namespace of a, Combine(a, b) differs from Combine(b, a),
+    so join tables can have computed primary keys instead of lookups
+ */
+
+func Combine(a, b UUID) UUID {
+	var name [16]byte
+	b.MarshalBinaryTo(name[:])
+	return Derive(a, name[:])
+}
+
+/**
+	Combines two IDs into the deterministic ID of the undirected relationship, e.g. pair or friendship
+
+    Same as Combine of the IDs ordered by ComparePostgres, so the order of arguments does not matter
+ */
+
+func CombineUnordered(a, b UUID) UUID {
+	if ComparePostgres(a, b) > 0 {
+		a, b = b, a
+	}
+	return Combine(a, b)
+}
+
+/**
+	Combines the tuple of IDs in order, Combine(Combine(a, b), c) for three IDs, Empty for no IDs
+ */
+
+func CombineTuple(ids ...UUID) UUID {
+	if len(ids) == 0 {
+		return Empty
+	}
+	result := ids[0]
+	for _, id := range ids[1:] {
+		result = Combine(result, id)
+	}
+	return result
+}
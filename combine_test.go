@@ -0,0 +1,32 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCombine(t *testing.T) {
+
+	a := uuid.MustParse("534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+	b := uuid.MustParse("017f22e2-79b0-7cc3-98c4-dc0c0c07398f")
+	c := uuid.NamespaceDNS
+
+	ab := uuid.Combine(a, b)
+	assert.Equal(t, ab, uuid.Combine(a, b))
+	assert.NotEqual(t, ab, uuid.Combine(b, a))
+	assert.Equal(t, uuid.NamebasedVer5, ab.Version())
+
+	assert.Equal(t, uuid.CombineUnordered(a, b), uuid.CombineUnordered(b, a))
+	assert.Equal(t, uuid.Combine(b, a), uuid.CombineUnordered(a, b))
+
+	assert.Equal(t, uuid.Combine(ab, c), uuid.CombineTuple(a, b, c))
+	assert.Equal(t, a, uuid.CombineTuple(a))
+	assert.Equal(t, uuid.Empty, uuid.CombineTuple())
+}
@@ -0,0 +1,163 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"math"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Fingerprint size of CompactSet keeping the least significant bits as is, no false merges
+ */
+
+const ExactFingerprint = 64
+
+/**
+	Compact set of UUIDs for dedup workloads indexed by the most significant 64 bits
+
+    Every member is stored as the high 64 bits and the fingerprint of the low 64 bits, members sharing
+    the high bits go to the overflow map. Fingerprints of 1 to 32 bits take 12 bytes per member instead of 16
+    at the cost of merging distinct UUIDs having equal high bits and fingerprints, ExactFingerprint keeps all bits.
+    Not safe for concurrent use.
+ */
+
+type CompactSet struct {
+	bits     int
+	approx   map[uint64]uint32
+	exact    map[uint64]uint64
+	overflow map[uint64][]uint64
+	len      int
+}
+
+/**
+	Creates set with fingerprints of 1 to 32 bits, or ExactFingerprint
+ */
+
+func NewCompactSet(fingerprintBits int) (*CompactSet, error) {
+	s := &CompactSet{bits: fingerprintBits, overflow: make(map[uint64][]uint64)}
+	switch {
+	case fingerprintBits == ExactFingerprint:
+		s.exact = make(map[uint64]uint64)
+	case fingerprintBits >= 1 && fingerprintBits <= 32:
+		s.approx = make(map[uint64]uint32)
+	default:
+		return nil, errors.Errorf("fingerprint bits %d must be in range [1, 32] or %d", fingerprintBits, ExactFingerprint)
+	}
+	return s, nil
+}
+
+/**
+	Gets size of fingerprints
+ */
+
+func (this *CompactSet) FingerprintBits() int {
+	return this.bits
+}
+
+/**
+	Adds UUID, returns false if it is already in the set or merged with a member
+ */
+
+func (this *CompactSet) Add(id UUID) bool {
+
+	fp := this.fingerprint(id)
+
+	var first uint64
+	var ok bool
+	if this.exact != nil {
+		first, ok = this.exact[id.MostSigBits]
+		if !ok {
+			this.exact[id.MostSigBits] = fp
+		}
+	} else {
+		var v uint32
+		v, ok = this.approx[id.MostSigBits]
+		first = uint64(v)
+		if !ok {
+			this.approx[id.MostSigBits] = uint32(fp)
+		}
+	}
+
+	if ok {
+		if first == fp {
+			return false
+		}
+		rest := this.overflow[id.MostSigBits]
+		for _, v := range rest {
+			if v == fp {
+				return false
+			}
+		}
+		this.overflow[id.MostSigBits] = append(rest, fp)
+	}
+
+	this.len++
+	return true
+}
+
+/**
+	Returns true if the UUID is in the set, or shares high bits and fingerprint with a member
+ */
+
+func (this *CompactSet) Contains(id UUID) bool {
+
+	fp := this.fingerprint(id)
+
+	var first uint64
+	var ok bool
+	if this.exact != nil {
+		first, ok = this.exact[id.MostSigBits]
+	} else {
+		var v uint32
+		v, ok = this.approx[id.MostSigBits]
+		first = uint64(v)
+	}
+
+	if !ok {
+		return false
+	}
+	if first == fp {
+		return true
+	}
+	for _, v := range this.overflow[id.MostSigBits] {
+		if v == fp {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+	Gets number of members
+ */
+
+func (this *CompactSet) Len() int {
+	return this.len
+}
+
+/**
+	Estimates probability that a new UUID is falsely merged with a member
+
+    Assumes the worst case of the new UUID sharing high bits with members, 0 for ExactFingerprint
+ */
+
+func (this *CompactSet) FalseMergeProbability() float64 {
+	if this.exact != nil || this.len == 0 {
+		return 0
+	}
+	// a new UUID is compared with fingerprints of all members under the same high bits
+	perKey := float64(this.len) / float64(len(this.approx))
+	return -math.Expm1(perKey * math.Log1p(-math.Exp2(-float64(this.bits))))
+}
+
+func (this *CompactSet) fingerprint(id UUID) uint64 {
+	if this.exact != nil {
+		return id.LeastSigBits
+	}
+	return mix64(id.LeastSigBits) >> (64 - this.bits)
+}
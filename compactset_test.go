@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompactSet(t *testing.T) {
+
+	random := func() uuid.UUID {
+		id := fixtureID()
+		return id
+	}
+
+	for _, bits := range []int{32, uuid.ExactFingerprint} {
+
+		s, err := uuid.NewCompactSet(bits)
+		assert.NoError(t, err)
+		assert.Equal(t, bits, s.FingerprintBits())
+
+		ids := make([]uuid.UUID, 1000)
+		for i := range ids {
+			ids[i] = random()
+			if i%2 == 1 {
+				// shares high bits with the previous one
+				ids[i].MostSigBits = ids[i-1].MostSigBits
+			}
+			assert.True(t, s.Add(ids[i]))
+		}
+		for _, id := range ids {
+			assert.True(t, s.Contains(id))
+			assert.False(t, s.Add(id))
+		}
+		assert.Equal(t, 1000, s.Len())
+
+		other := random()
+		assert.False(t, s.Contains(other))
+		other.MostSigBits = ids[0].MostSigBits
+		assert.False(t, s.Contains(other))
+	}
+
+	exact, _ := uuid.NewCompactSet(uuid.ExactFingerprint)
+	exact.Add(random())
+	assert.Equal(t, 0.0, exact.FalseMergeProbability())
+
+	// one-bit fingerprints merge half of the UUIDs sharing high bits
+	tiny, _ := uuid.NewCompactSet(1)
+	a := random()
+	assert.True(t, tiny.Add(a))
+	assert.InDelta(t, 0.5, tiny.FalseMergeProbability(), 1e-9)
+	merged := 0
+	for i := 0; i < 100; i++ {
+		b := random()
+		b.MostSigBits = a.MostSigBits
+		if tiny.Contains(b) {
+			merged++
+		}
+	}
+	assert.True(t, merged > 20 && merged < 80, "merged %d", merged)
+
+	_, err := uuid.NewCompactSet(0)
+	assert.Error(t, err)
+	_, err = uuid.NewCompactSet(48)
+	assert.Error(t, err)
+}
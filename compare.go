@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import "math/bits"
+
+/**
+	Comparators returning -1, 0 or +1, usable with slices.SortFunc and external merge sorts
+
+    Databases order UUIDs differently, pick the comparator matching the system issuing pagination cursors:
+
+    Postgres:   memcmp of 16 bytes, ComparePostgres
+    MySQL:      BINARY(16) and CHAR(36) columns are ordered by bytes, ComparePostgres
+    SQL Server: uniqueidentifier is ordered by the last group first, CompareSQLServer
+    Java:       UUID.compareTo compares signed 64-bit halves, CompareJava
+ */
+
+/**
+	Compares canonical lowercase strings lexicographically
+
+    Same as ComparePostgres, because hex digits preserve the byte order
+ */
+
+func CompareLexical(a, b UUID) int {
+	return ComparePostgres(a, b)
+}
+
+/**
+	Compares 16 bytes as unsigned big-endian values like memcmp in Postgres uuid_cmp
+ */
+
+func ComparePostgres(a, b UUID) int {
+	if c := compareUint64(a.MostSigBits, b.MostSigBits); c != 0 {
+		return c
+	}
+	return compareUint64(a.LeastSigBits, b.LeastSigBits)
+}
+
+/**
+	Compares like java.util.UUID.compareTo, the most and least significant bits as signed longs
+ */
+
+func CompareJava(a, b UUID) int {
+	if c := compareInt64(int64(a.MostSigBits), int64(b.MostSigBits)); c != 0 {
+		return c
+	}
+	return compareInt64(int64(a.LeastSigBits), int64(b.LeastSigBits))
+}
+
+/**
+	Compares like SQL Server uniqueidentifier
+
+    Groups are compared from right to left: node (6 bytes), clock sequence (2 bytes), then the first 8 bytes
+    from the last to the first, because uniqueidentifier keeps Data1, Data2 and Data3 little-endian,
+    the same byte order as SqlGuid.CompareTo in .NET
+ */
+
+func CompareSQLServer(a, b UUID) int {
+	// node followed by clock sequence
+	if c := compareUint64(bits.RotateLeft64(a.LeastSigBits, 16), bits.RotateLeft64(b.LeastSigBits, 16)); c != 0 {
+		return c
+	}
+	return compareUint64(bits.ReverseBytes64(a.MostSigBits), bits.ReverseBytes64(b.MostSigBits))
+}
+
+/**
+	Compares Time-based UUIDs by timestamp first, then by all bits like ComparePostgres
+
+    Versions 1, 6 and 7 are compared by unix time in 100 nanos, other versions are ordered after them
+ */
+
+func CompareTimeFirst(a, b UUID) int {
+
+	ta, okA := a.unixTime100Nanos()
+	tb, okB := b.unixTime100Nanos()
+
+	switch {
+	case okA && !okB:
+		return -1
+	case !okA && okB:
+		return 1
+	case okA && okB:
+		if c := compareInt64(ta, tb); c != 0 {
+			return c
+		}
+	}
+
+	return ComparePostgres(a, b)
+}
+
+func (this UUID) unixTime100Nanos() (int64, bool) {
+	switch this.Version() {
+	case TimebasedVer1:
+		return this.UnixTime100Nanos(), true
+	case ReorderedTimebasedVer6:
+		time100Nanos := (this.MostSigBits>>4)&0x0FFFFFFFFFFFF000 | this.MostSigBits&0x0FFF
+		return int64(time100Nanos) - num100NanosSinceUUIDEpoch, true
+	case TimebasedVer7:
+		return this.UnixTimeMillis() * one100NanosInMillis, true
+	default:
+		return 0, false
+	}
+}
+
+func compareUint64(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"bytes"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		a := uuid.Create(int64(r.Uint64()), int64(r.Uint64()))
+		b := uuid.Create(int64(r.Uint64()), int64(r.Uint64()))
+
+		ab, _ := a.MarshalBinary()
+		bb, _ := b.MarshalBinary()
+		assert.Equal(t, bytes.Compare(ab, bb), uuid.ComparePostgres(a, b))
+		assert.Equal(t, strings.Compare(a.String(), b.String()), uuid.CompareLexical(a, b))
+		assert.Equal(t, 0, uuid.ComparePostgres(a, a))
+	}
+
+	// Java compares signed halves
+	low := uuid.Create(1, 0)
+	high := uuid.Create(-1, 0)
+	assert.Equal(t, -1, uuid.ComparePostgres(low, high))
+	assert.Equal(t, 1, uuid.CompareJava(low, high))
+
+	// SQL Server compares node group first
+	a, _ := uuid.Parse("ffffffff-ffff-ffff-ffff-000000000001")
+	b, _ := uuid.Parse("00000000-0000-0000-0000-000000000002")
+	assert.Equal(t, -1, uuid.CompareSQLServer(a, b))
+	a, _ = uuid.Parse("00000000-0000-0000-0001-000000000000")
+	b, _ = uuid.Parse("ffffffff-ffff-ffff-0000-000000000000")
+	assert.Equal(t, 1, uuid.CompareSQLServer(a, b))
+
+	// Data1, Data2 and Data3 are little-endian
+	a, _ = uuid.Parse("00000001-0000-0000-0000-000000000000")
+	b, _ = uuid.Parse("00000100-0000-0000-0000-000000000000")
+	assert.Equal(t, 1, uuid.CompareSQLServer(a, b))
+	a, _ = uuid.Parse("00000000-0000-0000-0000-000000000001")
+	b, _ = uuid.Parse("ffffffff-0000-0000-0000-000000000000")
+	assert.Equal(t, 1, uuid.CompareSQLServer(a, b))
+	assert.Equal(t, 0, uuid.CompareSQLServer(a, a))
+
+	// ascending ORDER BY of uniqueidentifier in SQL Server
+	sqlServerOrder := []string{
+		"01000000-0000-0000-0000-000000000000",
+		"10000000-0000-0000-0000-000000000000",
+		"00010000-0000-0000-0000-000000000000",
+		"00100000-0000-0000-0000-000000000000",
+		"00000100-0000-0000-0000-000000000000",
+		"00001000-0000-0000-0000-000000000000",
+		"00000001-0000-0000-0000-000000000000",
+		"00000010-0000-0000-0000-000000000000",
+		"00000000-0100-0000-0000-000000000000",
+		"00000000-1000-0000-0000-000000000000",
+		"00000000-0001-0000-0000-000000000000",
+		"00000000-0010-0000-0000-000000000000",
+		"00000000-0000-0100-0000-000000000000",
+		"00000000-0000-1000-0000-000000000000",
+		"00000000-0000-0001-0000-000000000000",
+		"00000000-0000-0010-0000-000000000000",
+		"00000000-0000-0000-0001-000000000000",
+		"00000000-0000-0000-0010-000000000000",
+		"00000000-0000-0000-0100-000000000000",
+		"00000000-0000-0000-1000-000000000000",
+		"00000000-0000-0000-0000-000000000001",
+		"00000000-0000-0000-0000-000000000010",
+		"00000000-0000-0000-0000-000000000100",
+		"00000000-0000-0000-0000-000000001000",
+		"00000000-0000-0000-0000-000000010000",
+		"00000000-0000-0000-0000-000000100000",
+		"00000000-0000-0000-0000-000001000000",
+		"00000000-0000-0000-0000-000010000000",
+		"00000000-0000-0000-0000-000100000000",
+		"00000000-0000-0000-0000-001000000000",
+		"00000000-0000-0000-0000-010000000000",
+		"00000000-0000-0000-0000-100000000000",
+	}
+	ids := make([]uuid.UUID, len(sqlServerOrder))
+	for i, s := range sqlServerOrder {
+		ids[i] = uuid.MustParse(s)
+	}
+	r.Shuffle(len(ids), func(i, j int) { ids[i], ids[j] = ids[j], ids[i] })
+	sort.Slice(ids, func(i, j int) bool { return uuid.CompareSQLServer(ids[i], ids[j]) < 0 })
+	for i, id := range ids {
+		assert.Equal(t, sqlServerOrder[i], id.String())
+	}
+
+	// time first
+	v1 := uuid.New(uuid.TimebasedVer1)
+	v1.SetUnixTimeMillis(2000)
+	v7 := uuid.New(uuid.TimebasedVer7)
+	v7.MostSigBits |= uint64(1000) << 16
+	random, _ := uuid.RandomUUID()
+
+	ids = []uuid.UUID{random, v1, v7}
+	sort.Slice(ids, func(i, j int) bool { return uuid.CompareTimeFirst(ids[i], ids[j]) < 0 })
+	assert.Equal(t, []uuid.UUID{v7, v1, random}, ids)
+
+}
@@ -0,0 +1,284 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"context"
+	crand "crypto/rand"
+	"encoding/binary"
+	"io"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Node sources of GeneratorConfig, any other value is parsed as the 48-bit node itself
+ */
+
+const (
+	NodeRandom     = "random"
+	NodeMAC        = "mac"
+	NodeEnv        = "env"
+	NodeKubernetes = "k8s"
+	NodeEC2        = "ec2"
+	NodeGCE        = "gce"
+	NodeContainer  = "container"
+	NodeCloud      = "cloud"
+)
+
+/**
+	Entropy policies of GeneratorConfig
+ */
+
+const (
+	EntropyCrypto = "crypto"
+	EntropyFast   = "fast"
+	EntropyAuto   = "auto"
+	EntropyClock  = "clock"
+)
+
+/**
+	Generator settings loadable from JSON or YAML application config
+
+	version: 7
+	node: mac
+	stateFile: /var/lib/app/uuid.state
+	monotonic: true
+	clockRegression: wait
+	overflow: spin
+	entropy: crypto
+ */
+
+type GeneratorConfig struct {
+
+	/**
+		Version of generated UUIDs, 1, 4 or 7, DefaultVersion if zero
+	 */
+
+	Version int `json:"version,omitempty" yaml:"version,omitempty"`
+
+	/**
+		Node of version 1: "random" (default), "mac" of the first network interface,
+		"env" for UUID_NODE_ID, hash of "k8s" pod UID, "ec2" or "gce" instance ID, "container" ID,
+		"cloud" for the first available of them, or the node value like "02:00:5e:10:00:01"
+	 */
+
+	Node string `json:"node,omitempty" yaml:"node,omitempty"`
+
+	/**
+		Clock sequence of version 1, random if nil
+	 */
+
+	ClockSequence *int `json:"clockSequence,omitempty" yaml:"clockSequence,omitempty"`
+
+	/**
+		File keeping node, clock sequence and last timestamp between restarts of time-based generators
+
+	    The file is read and atomically rewritten when the generator is created and then every 10 seconds
+	    of timestamps, write errors are reported by Healthy. The clock sequence is incremented on every start
+	    as RFC 4122 section 4.2.1 recommends. The node is restored from the file only with the random node,
+	    so the process keeps its random node across restarts.
+	 */
+
+	StateFile string `json:"stateFile,omitempty" yaml:"stateFile,omitempty"`
+
+	/**
+		Directory of the lock files allocating distinct clock sequences to sibling processes, see Coordinator
+	 */
+
+	LockDir string `json:"lockDir,omitempty" yaml:"lockDir,omitempty"`
+
+	/**
+		Reads wall clock once and advances timestamps by the monotonic clock, immune to wall clock jumps,
+		resyncs every DefaultMonotonicResync with DefaultMonotonicMaxDrift, see MonotonicClock
+	 */
+
+	Monotonic bool `json:"monotonic,omitempty" yaml:"monotonic,omitempty"`
+
+	/**
+		Clock regression policy: "borrow" (default), "increment", "wait" or "fail"
+	 */
+
+	ClockRegression string `json:"clockRegression,omitempty" yaml:"clockRegression,omitempty"`
+
+	/**
+		Counter overflow policy: "borrow" (default), "spin" or "fail"
+	 */
+
+	Overflow string `json:"overflow,omitempty" yaml:"overflow,omitempty"`
+
+	/**
+		Precision of version 7 timestamps: "millis" (default), "micros" or "nanos"
+	 */
+
+	Precision string `json:"precision,omitempty" yaml:"precision,omitempty"`
+
+	/**
+		Source of random bits: "crypto" (default) for crypto/rand,
+		"fast" for math/rand seeded from crypto/rand, not suitable for unguessable IDs,
+		"auto" for crypto/rand that fails with ErrorUnsupportedFeature when PlatformFeatures does not report it,
+		"clock" for math/rand seeded from the clock, the explicit opt-in for targets without crypto/rand, guessable IDs
+	 */
+
+	Entropy string `json:"entropy,omitempty" yaml:"entropy,omitempty"`
+}
+
+/**
+	Creates generator configured by the settings
+ */
+
+func NewGeneratorFromConfig(cfg GeneratorConfig) (Generator, error) {
+
+	version := Version(cfg.Version)
+	if cfg.Version == 0 {
+		version = DefaultVersion()
+	}
+
+	reader, err := entropyReader(cfg.Entropy)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := ParseClockRegressionPolicy(cfg.ClockRegression)
+	if err != nil {
+		return nil, err
+	}
+
+	overflow, err := ParseOverflowPolicy(cfg.Overflow)
+	if err != nil {
+		return nil, err
+	}
+
+	precision, err := ParseTimestampPrecision(cfg.Precision)
+	if err != nil {
+		return nil, err
+	}
+	if precision != PrecisionMillis && version != TimebasedVer7 {
+		return nil, errors.Errorf("timestamp precision is supported only by version 7, got %v", version)
+	}
+
+	switch version {
+	case RandomlyGeneratedVer4:
+		return &RandomGenerator{Reader: reader}, nil
+	case TimebasedVer1, TimebasedVer7:
+	default:
+		return nil, errors.Errorf("unsupported generator version: %v", version)
+	}
+
+	gen, err := newTimeGenerator(version, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(strings.TrimSpace(cfg.Node)) {
+	case "", NodeRandom:
+	case NodeMAC:
+		node, err := macNode()
+		if err != nil {
+			return nil, err
+		}
+		gen.SetNode(node)
+	case NodeEnv:
+		node, ok, err := lookupNode(EnvNodeID)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, errors.Errorf("%s is not set", EnvNodeID)
+		}
+		gen.SetNode(node)
+	case NodeKubernetes, NodeEC2, NodeGCE, NodeContainer, NodeCloud:
+		node, err := NodeFrom(context.Background(), nodeSources(strings.ToLower(strings.TrimSpace(cfg.Node)))...)
+		if err != nil {
+			return nil, err
+		}
+		gen.SetNode(node)
+	default:
+		node, err := parseNode(strings.TrimSpace(cfg.Node))
+		if err != nil {
+			return nil, errors.Errorf("invalid node %q: %v", cfg.Node, err)
+		}
+		gen.SetNode(node)
+	}
+
+	if cfg.ClockSequence != nil {
+		gen.SetClockSequence(*cfg.ClockSequence)
+	}
+
+	if cfg.Monotonic {
+		gen.now = NewMonotonicClock(nil, DefaultMonotonicResync, DefaultMonotonicMaxDrift).Now
+	}
+	gen.regressionPolicy = policy
+	gen.overflowPolicy = overflow
+	gen.precision = precision
+
+	if cfg.LockDir != "" {
+		lease, err := NewCoordinator(cfg.LockDir, DefaultCoordinatorSlots).Acquire()
+		if err != nil {
+			return nil, err
+		}
+		lease.Apply(gen)
+	}
+
+	if cfg.StateFile != "" {
+		restoreNode := cfg.Node == "" || strings.EqualFold(strings.TrimSpace(cfg.Node), NodeRandom)
+		if err := gen.syncStateFile(cfg.StateFile, restoreNode); err != nil {
+			if gen.lease != nil {
+				gen.lease.Release()
+			}
+			return nil, err
+		}
+	}
+
+	return gen, nil
+}
+
+func nodeSources(name string) []NodeSource {
+	switch name {
+	case NodeKubernetes:
+		return []NodeSource{NodeFromKubernetes()}
+	case NodeEC2:
+		return []NodeSource{NodeFromEC2(DefaultEC2MetadataEndpoint)}
+	case NodeGCE:
+		return []NodeSource{NodeFromGCE(DefaultGCEMetadataEndpoint)}
+	case NodeContainer:
+		return []NodeSource{NodeFromContainer()}
+	default:
+		return []NodeSource{
+			NodeFromKubernetes(),
+			NodeFromContainer(),
+			NodeFromEC2(DefaultEC2MetadataEndpoint),
+			NodeFromGCE(DefaultGCEMetadataEndpoint),
+		}
+	}
+}
+
+func entropyReader(policy string) (io.Reader, error) {
+	switch strings.ToLower(strings.TrimSpace(policy)) {
+	case "", EntropyCrypto:
+		return crand.Reader, nil
+	case EntropyFast:
+		var seed [8]byte
+		if _, err := io.ReadFull(crand.Reader, seed[:]); err != nil {
+			return nil, errors.Wrap(err, "read entropy")
+		}
+		source := rand.NewSource(int64(binary.BigEndian.Uint64(seed[:])))
+		return &lockedReader{r: rand.New(source)}, nil
+	case EntropyAuto:
+		if !PlatformFeatures().CryptoEntropy {
+			return nil, errors.Wrap(ErrorUnsupportedFeature, "crypto/rand entropy, use \"clock\" to opt in to math/rand")
+		}
+		return crand.Reader, nil
+	case EntropyClock:
+		source := rand.NewSource(time.Now().UnixNano())
+		return &lockedReader{r: rand.New(source)}, nil
+	default:
+		return nil, errors.Errorf("unknown entropy policy: %q", policy)
+	}
+}
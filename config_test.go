@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGeneratorFromConfig(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	var cfg uuid.GeneratorConfig
+	err := json.Unmarshal([]byte(`{"version":1,"node":"02:00:5e:10:00:01","clockSequence":7,"monotonic":true,"entropy":"fast"}`), &cfg)
+	assert.NoError(t, err)
+
+	gen, err := uuid.NewGeneratorFromConfig(cfg)
+	assert.NoError(t, err)
+
+	prev, err := gen.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.TimebasedVer1, prev.Version())
+	assert.Equal(t, int64(0x02005e100001), prev.Node())
+	assert.Equal(t, 7, prev.ClockSequence())
+
+	for i := 0; i < 1000; i++ {
+		id, err := gen.Next()
+		assert.NoError(t, err)
+		assert.True(t, id.Time100Nanos() > prev.Time100Nanos())
+		prev = id
+	}
+
+	gen, err = uuid.NewGeneratorFromConfig(uuid.GeneratorConfig{Version: 4, Entropy: "fast"})
+	assert.NoError(t, err)
+	id, err := gen.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.RandomlyGeneratedVer4, id.Version())
+
+	gen, err = uuid.NewGeneratorFromConfig(uuid.GeneratorConfig{Version: 7, Entropy: uuid.EntropyClock})
+	assert.NoError(t, err)
+	id, err = gen.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.TimebasedVer7, id.Version())
+
+	_, err = uuid.NewGeneratorFromConfig(uuid.GeneratorConfig{Version: 5})
+	assert.Error(t, err)
+	_, err = uuid.NewGeneratorFromConfig(uuid.GeneratorConfig{Entropy: "weak"})
+	assert.Error(t, err)
+	_, err = uuid.NewGeneratorFromConfig(uuid.GeneratorConfig{Version: 1, Node: "not-a-node"})
+	assert.Error(t, err)
+
+}
+
+func TestGeneratorConfigStateFile(t *testing.T) {
+
+	dir, err := os.MkdirTemp("", "uuid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	seq := 100
+	cfg := uuid.GeneratorConfig{Version: 1, ClockSequence: &seq, StateFile: filepath.Join(dir, "uuid.state")}
+
+	gen, err := uuid.NewGeneratorFromConfig(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, 100, gen.(*uuid.TimeGenerator).ClockSequence())
+
+	cfg.ClockSequence = nil
+	gen, err = uuid.NewGeneratorFromConfig(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, 101, gen.(*uuid.TimeGenerator).ClockSequence())
+	node := gen.(*uuid.TimeGenerator).Node()
+
+	gen, err = uuid.NewGeneratorFromConfig(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, node, gen.(*uuid.TimeGenerator).Node())
+
+	cfg.Node = "02:00:5e:10:00:01"
+	gen, err = uuid.NewGeneratorFromConfig(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0x02005e100001), gen.(*uuid.TimeGenerator).Node())
+	assert.Equal(t, 103, gen.(*uuid.TimeGenerator).ClockSequence())
+	cfg.Node = ""
+
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	assert.NoError(t, os.WriteFile(cfg.StateFile, []byte("garbage"), 0644))
+	_, err = uuid.NewGeneratorFromConfig(cfg)
+	assert.Error(t, err)
+
+}
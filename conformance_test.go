@@ -0,0 +1,96 @@
+//go:build uuid_conformance
+// +build uuid_conformance
+
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+/**
+	Golden vectors produced by RFC-conforming implementations (google/uuid, Python uuid)
+	and by java.util.UUID.nameUUIDFromBytes
+
+    The suite is opt-in, run it by make conformance or go test -tags uuid_conformance.
+ */
+
+type conformanceVectors struct {
+	Parse []struct {
+		Input     string `json:"input"`
+		Canonical string `json:"canonical"`
+	} `json:"parse"`
+	Hash []struct {
+		Namespace string `json:"namespace"`
+		Name      string `json:"name"`
+		V3        string `json:"v3"`
+		V5        string `json:"v5"`
+	} `json:"hash"`
+	Java []struct {
+		Name string `json:"name"`
+		V3   string `json:"v3"`
+	} `json:"java"`
+}
+
+func TestConformance(t *testing.T) {
+
+	data, err := os.ReadFile("testdata/conformance.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var vectors conformanceVectors
+	if err := json.Unmarshal(data, &vectors); err != nil {
+		t.Fatal(err)
+	}
+
+	namespaces := map[string]uuid.UUID{
+		"dns":  uuid.NamespaceDNS,
+		"url":  uuid.NamespaceURL,
+		"oid":  uuid.NamespaceOID,
+		"x500": uuid.NamespaceX500,
+	}
+
+	for _, v := range vectors.Parse {
+		id, err := uuid.Parse(v.Input)
+		if v.Canonical == "" {
+			assert.Error(t, err, "input %q", v.Input)
+			continue
+		}
+		if assert.NoError(t, err, "input %q", v.Input) {
+			assert.Equal(t, v.Canonical, id.String(), "input %q", v.Input)
+		}
+	}
+
+	for _, v := range vectors.Hash {
+		ns, ok := namespaces[v.Namespace]
+		if !assert.True(t, ok, "namespace %q", v.Namespace) {
+			continue
+		}
+		v3, err := uuid.NewNameBased(ns, []byte(v.Name), uuid.NamebasedVer3)
+		assert.NoError(t, err)
+		assert.Equal(t, v.V3, v3.String(), "v3 %s %q", v.Namespace, v.Name)
+		assert.Equal(t, v.V3, uuid.NewMD5(ns, []byte(v.Name)).String(), "md5 %s %q", v.Namespace, v.Name)
+		v5, err := uuid.NewNameBased(ns, []byte(v.Name), uuid.NamebasedVer5)
+		assert.NoError(t, err)
+		assert.Equal(t, v.V5, v5.String(), "v5 %s %q", v.Namespace, v.Name)
+		assert.Equal(t, v.V5, uuid.NewSHA1(ns, []byte(v.Name)).String(), "sha1 %s %q", v.Namespace, v.Name)
+	}
+
+	for _, v := range vectors.Java {
+		id, err := uuid.NameUUIDFromBytes([]byte(v.Name), uuid.NamebasedVer3)
+		if assert.NoError(t, err) {
+			assert.Equal(t, v.V3, id.String(), "java %q", v.Name)
+		}
+	}
+
+}
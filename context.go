@@ -0,0 +1,31 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import "context"
+
+type contextKey struct{}
+
+var correlationIDKey = contextKey{}
+
+/**
+	Returns a copy of the context carrying the correlation ID
+
+    All libraries built on this package share the same key, so the ID stored by one is visible to others
+ */
+
+func NewContext(ctx context.Context, id UUID) context.Context {
+	return context.WithValue(ctx, correlationIDKey, id)
+}
+
+/**
+	Gets correlation ID stored in the context by NewContext
+ */
+
+func FromContext(ctx context.Context) (UUID, bool) {
+	id, ok := ctx.Value(correlationIDKey).(UUID)
+	return id, ok
+}
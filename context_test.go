@@ -0,0 +1,36 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContext(t *testing.T) {
+
+	ctx := context.Background()
+
+	_, ok := uuid.FromContext(ctx)
+	assert.False(t, ok)
+
+	id := fixtureID()
+
+	ctx = uuid.NewContext(ctx, id)
+	actual, ok := uuid.FromContext(ctx)
+	assert.True(t, ok)
+	assert.True(t, id.Equal(actual))
+
+	// string keys must not collide with the private key
+	ctx = context.WithValue(ctx, "uuid", "value")
+	actual, ok = uuid.FromContext(ctx)
+	assert.True(t, ok)
+	assert.True(t, id.Equal(actual))
+
+}
@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+var ErrorNoFreeSlot = errors.New("all coordination slots are taken")
+
+/**
+	Allocates distinct clock sequences to the processes of the same host via advisory file locks
+
+    Sibling processes sharing the node get different clock sequences, so their version 1 UUIDs never collide.
+    Slot N owns clock sequences N, N+slots, N+2*slots and so on, the first half of them is live and the second
+    half is kept for NextAt. The leased generator increments its clock sequence only within the live half
+    and borrows timestamps once it is exhausted.
+    Locks are released by the OS when the process exits, so crashed processes do not leak slots.
+ */
+
+type Coordinator struct {
+	dir   string
+	slots int
+}
+
+/**
+	Lock on the slot held by the current process
+ */
+
+type Lease struct {
+	slot  int
+	slots int
+	file  *os.File
+}
+
+/**
+	Number of slots of the coordinator created by GeneratorConfig.LockDir, 64 clock sequences per slot, 32 of them live
+ */
+
+const DefaultCoordinatorSlots = 256
+
+/**
+	Creates coordinator keeping lock files in the directory, zero slots means 16384 slots of one clock sequence
+ */
+
+func NewCoordinator(dir string, slots int) *Coordinator {
+	if slots <= 0 || slots > clockSequenceBits+1 {
+		slots = clockSequenceBits + 1
+	}
+	return &Coordinator{dir: dir, slots: slots}
+}
+
+/**
+	Locks the first free slot, the lease must stay referenced while the slot is in use
+ */
+
+func (this *Coordinator) Acquire() (*Lease, error) {
+
+	if err := os.MkdirAll(this.dir, 0755); err != nil {
+		return nil, errors.Wrapf(err, "create lock dir '%s'", this.dir)
+	}
+
+	for slot := 0; slot < this.slots; slot++ {
+		path := filepath.Join(this.dir, fmt.Sprintf("slot-%05d.lock", slot))
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0644)
+		if err != nil {
+			return nil, errors.Wrapf(err, "open lock file '%s'", path)
+		}
+		locked, err := tryLockFile(file)
+		if err != nil {
+			file.Close()
+			return nil, errors.Wrapf(err, "lock file '%s'", path)
+		}
+		if locked {
+			return &Lease{slot: slot, slots: this.slots, file: file}, nil
+		}
+		file.Close()
+	}
+
+	return nil, ErrorNoFreeSlot
+}
+
+/**
+	Gets number of the locked slot
+ */
+
+func (this *Lease) Slot() int {
+	return this.slot
+}
+
+/**
+	Sets clock sequence of the generator to the slot number and keeps its increments within the slot
+ */
+
+func (this *Lease) Apply(gen *TimeGenerator) {
+	gen.SetClockSequence(this.slot)
+	gen.Lock()
+	gen.lease = this
+	gen.Unlock()
+}
+
+/**
+	Gets number of the clock sequences of the slot kept for live UUIDs, the rest is kept for NextAt
+
+    The slot of a single clock sequence shares it between live and backfilled UUIDs.
+ */
+
+func (this *Lease) liveSequences() (live, count int) {
+	count = (clockSequenceBits + 1 - this.slot + this.slots - 1) / this.slots
+	if count == 1 {
+		return 1, 1
+	}
+	return (count + 1) / 2, count
+}
+
+/**
+	Gets live clock sequence of the slot following the given one, wraps to the first clock sequence of the slot
+ */
+
+func (this *Lease) advance(clockSequence int) int {
+	live, _ := this.liveSequences()
+	next := 0
+	if clockSequence >= this.slot && (clockSequence-this.slot)%this.slots == 0 {
+		next = ((clockSequence-this.slot)/this.slots + 1) % live
+	}
+	return this.slot + next*this.slots
+}
+
+/**
+	Gets clock sequence of the slot for the n-th backfilled UUID, never taken by the live UUIDs of the slot
+ */
+
+func (this *Lease) backfill(n int64) int {
+	live, count := this.liveSequences()
+	if count == live {
+		return this.slot
+	}
+	return this.slot + (live+int(n%int64(count-live)))*this.slots
+}
+
+/**
+	Releases the slot
+ */
+
+func (this *Lease) Release() error {
+	return this.file.Close()
+}
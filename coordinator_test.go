@@ -0,0 +1,168 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoordinator(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	if runtime.GOOS == "windows" || runtime.GOOS == "plan9" || runtime.GOOS == "js" {
+		t.Skip("advisory file locks are not supported")
+	}
+
+	dir, err := os.MkdirTemp("", "uuid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	coordinator := uuid.NewCoordinator(dir, 2)
+
+	first, err := coordinator.Acquire()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, first.Slot())
+
+	second, err := coordinator.Acquire()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, second.Slot())
+
+	_, err = coordinator.Acquire()
+	assert.Equal(t, uuid.ErrorNoFreeSlot, err)
+
+	assert.NoError(t, first.Release())
+	third, err := coordinator.Acquire()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, third.Slot())
+
+	gen, err := uuid.NewTimeGenerator(uuid.TimebasedVer1)
+	assert.NoError(t, err)
+	second.Apply(gen)
+	id, err := gen.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, id.ClockSequence())
+
+	cfgGen, err := uuid.NewGeneratorFromConfig(uuid.GeneratorConfig{Version: 1, LockDir: dir})
+	assert.NoError(t, err)
+	id, err = cfgGen.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, id.ClockSequence())
+
+	third.Release()
+	second.Release()
+}
+
+func TestCoordinatorIncrementSequence(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	if runtime.GOOS == "windows" || runtime.GOOS == "plan9" || runtime.GOOS == "js" {
+		t.Skip("advisory file locks are not supported")
+	}
+
+	dir, err := os.MkdirTemp("", "uuid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	coordinator := uuid.NewCoordinator(dir, 4)
+	first, err := coordinator.Acquire()
+	assert.NoError(t, err)
+	defer first.Release()
+	second, err := coordinator.Acquire()
+	assert.NoError(t, err)
+	defer second.Release()
+
+	clock := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	gen, err := uuid.NewTimeGenerator(uuid.TimebasedVer1)
+	assert.NoError(t, err)
+	gen.SetClock(func() time.Time { return clock })
+	gen.SetClockRegressionPolicy(uuid.ClockRegressionIncrementSequence)
+	second.Apply(gen)
+
+	for i := 0; i < 8; i++ {
+		_, err = gen.Next()
+		assert.NoError(t, err)
+		clock = clock.Add(-time.Second)
+		id, err := gen.Next()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, id.ClockSequence()%4, "clock sequence %d left slot 1", id.ClockSequence())
+	}
+
+	small := uuid.NewCoordinator(filepath.Join(dir, "small"), 16384)
+	lease, err := small.Acquire()
+	assert.NoError(t, err)
+	defer lease.Release()
+
+	gen, err = uuid.NewTimeGenerator(uuid.TimebasedVer1)
+	assert.NoError(t, err)
+	gen.SetClock(func() time.Time { return clock })
+	gen.SetClockRegressionPolicy(uuid.ClockRegressionIncrementSequence)
+	lease.Apply(gen)
+
+	last, err := gen.Next()
+	assert.NoError(t, err)
+	clock = clock.Add(-time.Second)
+	id, err := gen.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, id.ClockSequence())
+	assert.True(t, id.Time100Nanos() > last.Time100Nanos())
+
+	previous, err := uuid.NewTimeGenerator(uuid.TimebasedVer1)
+	assert.NoError(t, err)
+	previous.SetClockSequence(0)
+	data, err := previous.SaveState()
+	assert.NoError(t, err)
+	state := filepath.Join(dir, "uuid.state")
+	assert.NoError(t, os.WriteFile(state, data, 0600))
+
+	cfgGen, err := uuid.NewGeneratorFromConfig(uuid.GeneratorConfig{Version: 1, LockDir: filepath.Join(dir, "cfg"), StateFile: state})
+	assert.NoError(t, err)
+	id, err = cfgGen.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.DefaultCoordinatorSlots, id.ClockSequence())
+}
+
+func TestCoordinatorReleaseOnStateError(t *testing.T) {
+
+	if runtime.GOOS == "windows" || runtime.GOOS == "plan9" || runtime.GOOS == "js" {
+		t.Skip("advisory file locks are not supported")
+	}
+
+	dir, err := os.MkdirTemp("", "uuid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	state := filepath.Join(dir, "uuid.state")
+	assert.NoError(t, os.WriteFile(state, []byte("{broken"), 0600))
+
+	locks := filepath.Join(dir, "locks")
+	_, err = uuid.NewGeneratorFromConfig(uuid.GeneratorConfig{Version: 1, LockDir: locks, StateFile: state})
+	assert.Error(t, err)
+
+	// the slot of the failed generator is free again
+	lease, err := uuid.NewCoordinator(locks, uuid.DefaultCoordinatorSlots).Acquire()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, lease.Slot())
+	lease.Release()
+}
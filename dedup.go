@@ -0,0 +1,427 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"bufio"
+	"container/heap"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Default number of UUIDs kept in memory by DuplicateFinder before spilling a sorted run to disk, 64MB
+ */
+
+const DefaultDuplicateFinderMemory = 4 << 20
+
+/**
+	Default max number of runs merged at once by DuplicateFinder, bounds the open files and read buffers
+ */
+
+const DefaultDuplicateFinderFanIn = 64
+
+/**
+	Totals reported by DuplicateFinder
+ */
+
+type DuplicateReport struct {
+
+	/**
+		Number of added UUIDs
+	 */
+
+	Total int64
+
+	/**
+		Number of distinct UUIDs
+	 */
+
+	Unique int64
+
+	/**
+		Number of distinct UUIDs seen more than once
+	 */
+
+	Duplicated int64
+}
+
+/**
+	External-memory duplicate finder for billions of UUIDs with bounded memory
+
+    UUIDs are buffered in memory, sorted and spilled to temporary files as 16-byte records,
+    then the runs are merged reporting every duplicated UUID with its count. At most fan-in runs are open
+    at once, more runs are first merged into the longer intermediate runs in multiple passes.
+    Not safe for concurrent use.
+ */
+
+type DuplicateFinder struct {
+	dir    string
+	limit  int
+	fanIn  int
+	buffer []UUID
+	runs   []string
+	total  int64
+}
+
+/**
+	Creates duplicate finder spilling runs to the directory, os.TempDir if empty,
+    and keeping at most memory UUIDs in memory, DefaultDuplicateFinderMemory if not positive
+ */
+
+func NewDuplicateFinder(dir string, memory int) *DuplicateFinder {
+	if memory <= 0 {
+		memory = DefaultDuplicateFinderMemory
+	}
+	return &DuplicateFinder{dir: dir, limit: memory, fanIn: DefaultDuplicateFinderFanIn}
+}
+
+/**
+	Sets max number of runs merged at once, at least 2, DefaultDuplicateFinderFanIn by default
+ */
+
+func (this *DuplicateFinder) SetFanIn(fanIn int) {
+	if fanIn < 2 {
+		fanIn = 2
+	}
+	this.fanIn = fanIn
+}
+
+/**
+	Adds UUID, spills a sorted run when the memory limit is reached
+ */
+
+func (this *DuplicateFinder) Add(id UUID) error {
+	this.buffer = append(this.buffer, id)
+	this.total++
+	if len(this.buffer) >= this.limit {
+		return this.spill()
+	}
+	return nil
+}
+
+/**
+	Adds UUIDs from the stream of 16-byte binary records
+ */
+
+func (this *DuplicateFinder) ReadBinary(r io.Reader) error {
+	in := bufio.NewReaderSize(r, 64*1024)
+	var record [16]byte
+	for {
+		if _, err := io.ReadFull(in, record[:]); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return errors.Wrap(err, "read record")
+		}
+		var id UUID
+		if err := id.UnmarshalBinary(record[:]); err != nil {
+			return err
+		}
+		if err := this.Add(id); err != nil {
+			return err
+		}
+	}
+}
+
+/**
+	Adds UUIDs from the stream of text lines in any format accepted by Parse, empty lines are skipped
+ */
+
+func (this *DuplicateFinder) ReadText(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := scanner.Bytes()
+		if len(text) == 0 {
+			continue
+		}
+		id, err := ParseBytes(text)
+		if err != nil {
+			return errors.Wrapf(err, "line %d", line)
+		}
+		if err := this.Add(id); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+/**
+	Merges the runs calling the callback for every duplicated UUID in ComparePostgres order
+    with the number of its occurrences, removes temporary files
+
+    The finder is reset and can be reused after the call.
+ */
+
+func (this *DuplicateFinder) Finish(duplicate func(id UUID, count int64) error) (report DuplicateReport, err error) {
+
+	defer this.reset()
+
+	report.Total = this.total
+
+	if len(this.runs) == 0 {
+		sortUUIDs(this.buffer)
+		err = emitDuplicates(&sliceIterator{ids: this.buffer}, &report, duplicate)
+		return
+	}
+
+	if len(this.buffer) > 0 {
+		if err = this.spill(); err != nil {
+			return
+		}
+	}
+
+	for len(this.runs) > this.fanIn {
+		if err = this.mergePass(); err != nil {
+			return
+		}
+	}
+
+	h, closeRuns, err := openRuns(this.runs)
+	defer closeRuns()
+	if err != nil {
+		return
+	}
+
+	err = emitDuplicates(h, &report, duplicate)
+	return
+}
+
+/**
+	Merges the first fan-in runs into the intermediate run appended to the end, keeps duplicates
+ */
+
+func (this *DuplicateFinder) mergePass() error {
+
+	inputs := this.runs[:this.fanIn]
+
+	h, closeRuns, err := openRuns(inputs)
+	defer closeRuns()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(this.dir, "uuid-run-*")
+	if err != nil {
+		return errors.Wrap(err, "create run")
+	}
+	this.runs = append(this.runs, f.Name())
+
+	out := bufio.NewWriterSize(f, 64*1024)
+	var record [16]byte
+	for {
+		id, ok, err := h.pop()
+		if err != nil {
+			f.Close()
+			return err
+		}
+		if !ok {
+			break
+		}
+		id.MarshalBinaryTo(record[:])
+		if _, err := out.Write(record[:]); err != nil {
+			f.Close()
+			return errors.Wrap(err, "write run")
+		}
+	}
+	if err := out.Flush(); err != nil {
+		f.Close()
+		return errors.Wrap(err, "write run")
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrap(err, "write run")
+	}
+
+	closeRuns()
+	for _, name := range inputs {
+		os.Remove(name)
+	}
+	this.runs = this.runs[len(inputs):]
+	return nil
+}
+
+/**
+	Opens the runs positioned at their first UUIDs, the returned func closes them and is safe to call twice
+ */
+
+func openRuns(names []string) (*runHeap, func(), error) {
+
+	h := make(runHeap, 0, len(names))
+	var files []*os.File
+	closeRuns := func() {
+		for _, f := range files {
+			f.Close()
+		}
+		files = nil
+	}
+
+	for _, name := range names {
+		f, err := os.Open(name)
+		if err != nil {
+			return nil, closeRuns, errors.Wrap(err, "open run")
+		}
+		files = append(files, f)
+		r := &runReader{f: f, in: bufio.NewReaderSize(f, 64*1024)}
+		ok, err := r.next()
+		if err != nil {
+			return nil, closeRuns, err
+		}
+		if ok {
+			h = append(h, r)
+		}
+	}
+	heap.Init(&h)
+	return &h, closeRuns, nil
+}
+
+func (this *DuplicateFinder) spill() error {
+
+	sortUUIDs(this.buffer)
+
+	f, err := os.CreateTemp(this.dir, "uuid-run-*")
+	if err != nil {
+		return errors.Wrap(err, "create run")
+	}
+	this.runs = append(this.runs, f.Name())
+
+	out := bufio.NewWriterSize(f, 64*1024)
+	var record [16]byte
+	for _, id := range this.buffer {
+		id.MarshalBinaryTo(record[:])
+		if _, err := out.Write(record[:]); err != nil {
+			f.Close()
+			return errors.Wrap(err, "write run")
+		}
+	}
+	if err := out.Flush(); err != nil {
+		f.Close()
+		return errors.Wrap(err, "write run")
+	}
+	this.buffer = this.buffer[:0]
+	return f.Close()
+}
+
+func (this *DuplicateFinder) reset() {
+	for _, name := range this.runs {
+		os.Remove(name)
+	}
+	this.runs = nil
+	this.buffer = nil
+	this.total = 0
+}
+
+type uuidIterator interface {
+	pop() (UUID, bool, error)
+}
+
+func emitDuplicates(it uuidIterator, report *DuplicateReport, duplicate func(id UUID, count int64) error) error {
+
+	var current UUID
+	var count int64
+
+	flush := func() error {
+		if count == 0 {
+			return nil
+		}
+		report.Unique++
+		if count > 1 {
+			report.Duplicated++
+			if duplicate != nil {
+				return duplicate(current, count)
+			}
+		}
+		return nil
+	}
+
+	for {
+		id, ok, err := it.pop()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return flush()
+		}
+		if count > 0 && id == current {
+			count++
+			continue
+		}
+		if err := flush(); err != nil {
+			return err
+		}
+		current, count = id, 1
+	}
+}
+
+func sortUUIDs(ids []UUID) {
+	sort.Slice(ids, func(i, j int) bool { return ComparePostgres(ids[i], ids[j]) < 0 })
+}
+
+type sliceIterator struct {
+	ids []UUID
+}
+
+func (this *sliceIterator) pop() (UUID, bool, error) {
+	if len(this.ids) == 0 {
+		return Empty, false, nil
+	}
+	id := this.ids[0]
+	this.ids = this.ids[1:]
+	return id, true, nil
+}
+
+type runReader struct {
+	f       *os.File
+	in      *bufio.Reader
+	current UUID
+}
+
+func (this *runReader) next() (bool, error) {
+	var record [16]byte
+	if _, err := io.ReadFull(this.in, record[:]); err != nil {
+		if err == io.EOF {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "read run")
+	}
+	return true, this.current.UnmarshalBinary(record[:])
+}
+
+type runHeap []*runReader
+
+func (h runHeap) Len() int            { return len(h) }
+func (h runHeap) Less(i, j int) bool  { return ComparePostgres(h[i].current, h[j].current) < 0 }
+func (h runHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *runHeap) Push(x interface{}) { *h = append(*h, x.(*runReader)) }
+
+func (h *runHeap) Pop() interface{} {
+	old := *h
+	r := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return r
+}
+
+func (h *runHeap) pop() (UUID, bool, error) {
+	if len(*h) == 0 {
+		return Empty, false, nil
+	}
+	r := (*h)[0]
+	id := r.current
+	ok, err := r.next()
+	if err != nil {
+		return Empty, false, err
+	}
+	if ok {
+		heap.Fix(h, 0)
+	} else {
+		heap.Pop(h)
+	}
+	return id, true, nil
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDuplicateFinder(t *testing.T) {
+
+	dir := t.TempDir()
+
+	ids := make([]uuid.UUID, 100)
+	for i := range ids {
+		ids[i] = uuid.New(uuid.RandomlyGeneratedVer4)
+		ids[i].SetCounter(int64(i))
+	}
+
+	for _, memory := range []int{0, 7} {
+
+		f := uuid.NewDuplicateFinder(dir, memory)
+		for _, id := range ids {
+			assert.NoError(t, f.Add(id))
+		}
+		for i := 0; i < 3; i++ {
+			assert.NoError(t, f.Add(ids[42]))
+		}
+		assert.NoError(t, f.Add(ids[7]))
+
+		var found []uuid.UUID
+		var counts []int64
+		report, err := f.Finish(func(id uuid.UUID, count int64) error {
+			found = append(found, id)
+			counts = append(counts, count)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, uuid.DuplicateReport{Total: 104, Unique: 100, Duplicated: 2}, report)
+		assert.Equal(t, 2, len(found))
+		assert.ElementsMatch(t, []uuid.UUID{ids[7], ids[42]}, found)
+		assert.ElementsMatch(t, []int64{2, 4}, counts)
+
+		entries, err := os.ReadDir(dir)
+		assert.NoError(t, err)
+		assert.Empty(t, entries)
+	}
+
+	// 15 runs merged two at a time in multiple passes
+	f := uuid.NewDuplicateFinder(dir, 7)
+	f.SetFanIn(2)
+	for _, id := range ids {
+		assert.NoError(t, f.Add(id))
+	}
+	assert.NoError(t, f.Add(ids[99]))
+	report, err := f.Finish(func(id uuid.UUID, count int64) error {
+		assert.Equal(t, ids[99], id)
+		assert.Equal(t, int64(2), count)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.DuplicateReport{Total: 101, Unique: 100, Duplicated: 1}, report)
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+
+	f = uuid.NewDuplicateFinder(dir, 2)
+	text := ids[0].String() + "\n\n" + ids[1].String() + "\n" + ids[0].String() + "\n"
+	assert.NoError(t, f.ReadText(strings.NewReader(text)))
+
+	var binary bytes.Buffer
+	for _, id := range ids[1:4] {
+		b, _ := id.MarshalBinary()
+		binary.Write(b)
+	}
+	assert.NoError(t, f.ReadBinary(&binary))
+
+	report, err = f.Finish(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.DuplicateReport{Total: 6, Unique: 4, Duplicated: 2}, report)
+
+	assert.Error(t, f.ReadText(strings.NewReader("not-a-uuid\n")))
+	report, err = f.Finish(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), report.Total)
+}
@@ -0,0 +1,246 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Environment variables read by ConfigureFromEnv
+ */
+
+const (
+	EnvNodeID         = "UUID_NODE_ID"
+	EnvClockSequence  = "UUID_CLOCK_SEQ"
+	EnvVersionDefault = "UUID_VERSION_DEFAULT"
+)
+
+var (
+	defaultLock    sync.RWMutex
+	defaultVersion = RandomlyGeneratedVer4
+	defaultRandom  = NewRandomGenerator()
+
+	defaultV1Once      sync.Once
+	defaultV1Generator *TimeGenerator
+	defaultV1Error     error
+)
+
+func defaultTimeGenerator(version Version) (*TimeGenerator, error) {
+	if version == TimebasedVer7 {
+		defaultV7Once.Do(func() {
+			defaultV7Generator, defaultV7Error = NewTimeGenerator(TimebasedVer7)
+		})
+		return defaultV7Generator, defaultV7Error
+	}
+	defaultV1Once.Do(func() {
+		defaultV1Generator, defaultV1Error = NewTimeGenerator(TimebasedVer1)
+	})
+	return defaultV1Generator, defaultV1Error
+}
+
+/**
+	Gets process-wide generator of the specific version
+
+    Supported versions are TimebasedVer1, RandomlyGeneratedVer4 and TimebasedVer7
+ */
+
+func DefaultGeneratorOf(version Version) (Generator, error) {
+	switch version {
+	case RandomlyGeneratedVer4:
+		return defaultRandom, nil
+	case TimebasedVer1, TimebasedVer7:
+		return defaultTimeGenerator(version)
+	default:
+		return nil, errors.Errorf("unsupported generator version: %v", version)
+	}
+}
+
+/**
+	Gets version used by NewDefault, RandomlyGeneratedVer4 unless configured
+ */
+
+func DefaultVersion() Version {
+	defaultLock.RLock()
+	defer defaultLock.RUnlock()
+	return defaultVersion
+}
+
+/**
+	Sets version used by NewDefault
+ */
+
+func SetDefaultVersion(version Version) error {
+	if _, err := DefaultGeneratorOf(version); err != nil {
+		return err
+	}
+	defaultLock.Lock()
+	defer defaultLock.Unlock()
+	defaultVersion = version
+	return nil
+}
+
+/**
+	Gets process-wide generator of the default version
+ */
+
+func DefaultGenerator() (Generator, error) {
+	return DefaultGeneratorOf(DefaultVersion())
+}
+
+/**
+	Generates UUID of the default version
+ */
+
+func NewDefault() (UUID, error) {
+	gen, err := DefaultGenerator()
+	if err != nil {
+		return Empty, err
+	}
+	return gen.Next()
+}
+
+/**
+	Generates version 4 UUID by the process-wide generator, safe for concurrent use
+
+    Stateless, every UUID has 122 random bits of crypto/rand
+ */
+
+func NewV4() (UUID, error) {
+	return defaultRandom.Next()
+}
+
+/**
+	Generates version 1 UUID by the process-wide generator, safe for concurrent use
+
+    The generator is created on the first call with the random node and clock sequence,
+    ConfigureFromEnv sets them from UUID_NODE_ID and UUID_CLOCK_SEQ.
+    UUIDs generated by the same process have strictly increasing timestamps.
+ */
+
+func NewV1() (UUID, error) {
+	gen, err := defaultTimeGenerator(TimebasedVer1)
+	if err != nil {
+		return Empty, err
+	}
+	return gen.Next()
+}
+
+/**
+	Configures default generators from the environment variables
+
+	UUID_NODE_ID:         48-bit node of version 1, hex with ':' or '-' separators like MAC address,
+	                      12 hex digits, 0x prefixed hex or decimal of other length
+	UUID_CLOCK_SEQ:       14-bit clock sequence of version 1, decimal or 0x prefixed hex
+	UUID_VERSION_DEFAULT: version of NewDefault, one of 1, 4 and 7
+
+    Unset variables keep current values, nothing is applied if any variable is invalid
+ */
+
+func ConfigureFromEnv() error {
+
+	node, hasNode, err := lookupNode(EnvNodeID)
+	if err != nil {
+		return err
+	}
+
+	clockSequence, hasClockSequence, err := lookupInt(EnvClockSequence, int64(clockSequenceBits))
+	if err != nil {
+		return err
+	}
+
+	version, hasVersion, err := lookupInt(EnvVersionDefault, int64(UnknownVersion))
+	if err != nil {
+		return err
+	}
+	if hasVersion {
+		if _, err := DefaultGeneratorOf(Version(version)); err != nil {
+			return errors.Wrapf(err, "invalid %s", EnvVersionDefault)
+		}
+	}
+
+	if hasNode || hasClockSequence {
+		gen, err := defaultTimeGenerator(TimebasedVer1)
+		if err != nil {
+			return err
+		}
+		if hasNode {
+			gen.SetNode(node)
+		}
+		if hasClockSequence {
+			gen.SetClockSequence(int(clockSequence))
+		}
+	}
+
+	if hasVersion {
+		return SetDefaultVersion(Version(version))
+	}
+	return nil
+}
+
+func lookupNode(name string) (int64, bool, error) {
+
+	value, ok := os.LookupEnv(name)
+	value = strings.TrimSpace(value)
+	if !ok || value == "" {
+		return 0, false, nil
+	}
+
+	node, err := parseNode(value)
+	if err != nil {
+		return 0, false, errors.Errorf("invalid %s: %v", name, err)
+	}
+	return node, true, nil
+}
+
+/**
+	Parses 48-bit node, hex with ':' or '-' separators like MAC address, 0x prefixed hex or decimal
+
+    Exactly 12 digits without separators are hex, a MAC address written as 001122334455 is not read as decimal.
+ */
+
+func parseNode(value string) (int64, error) {
+
+	base := 10
+	if strings.ContainsAny(value, ":-") {
+		value = strings.NewReplacer(":", "", "-", "").Replace(value)
+		base = 16
+	} else if strings.HasPrefix(value, "0x") || strings.HasPrefix(value, "0X") {
+		value = value[2:]
+		base = 16
+	} else if len(value) == 12 {
+		base = 16
+	}
+
+	node, err := strconv.ParseUint(value, base, 48)
+	if err != nil {
+		return 0, err
+	}
+	return int64(node), nil
+}
+
+func lookupInt(name string, max int64) (int64, bool, error) {
+
+	value, ok := os.LookupEnv(name)
+	value = strings.TrimSpace(value)
+	if !ok || value == "" {
+		return 0, false, nil
+	}
+
+	n, err := strconv.ParseInt(value, 0, 64)
+	if err != nil {
+		return 0, false, errors.Errorf("invalid %s: %v", name, err)
+	}
+	if n < 0 || n > max {
+		return 0, false, errors.Errorf("invalid %s: %d out of range [0, %d]", name, n, max)
+	}
+	return n, true, nil
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigureFromEnv(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	defer uuid.SetDefaultVersion(uuid.DefaultVersion())
+
+	id, err := uuid.NewDefault()
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.DefaultVersion(), id.Version())
+
+	os.Setenv(uuid.EnvNodeID, "02:00:5e:10:00:01")
+	os.Setenv(uuid.EnvClockSequence, "0x123")
+	os.Setenv(uuid.EnvVersionDefault, "1")
+	defer os.Unsetenv(uuid.EnvNodeID)
+	defer os.Unsetenv(uuid.EnvClockSequence)
+	defer os.Unsetenv(uuid.EnvVersionDefault)
+
+	assert.NoError(t, uuid.ConfigureFromEnv())
+	assert.Equal(t, uuid.TimebasedVer1, uuid.DefaultVersion())
+
+	id, err = uuid.NewDefault()
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.TimebasedVer1, id.Version())
+	assert.Equal(t, int64(0x02005e100001), id.Node())
+	assert.Equal(t, 0x123, id.ClockSequence())
+
+	os.Setenv(uuid.EnvVersionDefault, "7")
+	os.Setenv(uuid.EnvNodeID, "123456")
+	assert.NoError(t, uuid.ConfigureFromEnv())
+	id, err = uuid.NewDefault()
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.TimebasedVer7, id.Version())
+
+	gen, err := uuid.DefaultGeneratorOf(uuid.TimebasedVer1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(123456), gen.(*uuid.TimeGenerator).Node())
+
+	for value, node := range map[string]int64{"001122334455": 0x001122334455, "00112233aabb": 0x00112233aabb, "0x123456": 0x123456, "00-11-22-33-44-55": 0x001122334455} {
+		os.Setenv(uuid.EnvNodeID, value)
+		assert.NoError(t, uuid.ConfigureFromEnv())
+		gen, err = uuid.DefaultGeneratorOf(uuid.TimebasedVer1)
+		assert.NoError(t, err)
+		assert.Equal(t, node, gen.(*uuid.TimeGenerator).Node(), "node %s", value)
+	}
+
+	os.Setenv(uuid.EnvVersionDefault, "3")
+	assert.Error(t, uuid.ConfigureFromEnv())
+	assert.Equal(t, uuid.TimebasedVer7, uuid.DefaultVersion())
+
+	os.Setenv(uuid.EnvVersionDefault, "4")
+	os.Setenv(uuid.EnvClockSequence, "16384")
+	assert.Error(t, uuid.ConfigureFromEnv())
+
+	os.Setenv(uuid.EnvClockSequence, "1")
+	os.Setenv(uuid.EnvNodeID, "zz:00")
+	assert.Error(t, uuid.ConfigureFromEnv())
+
+	assert.Error(t, uuid.SetDefaultVersion(uuid.NamebasedVer5))
+
+}
+
+func TestNewVersionFunctions(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	seen := make(map[uuid.UUID]bool)
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				for _, fn := range []func() (uuid.UUID, error){uuid.NewV1, uuid.NewV4, uuid.NewV7} {
+					id, err := fn()
+					assert.NoError(t, err)
+					mu.Lock()
+					assert.False(t, seen[id])
+					seen[id] = true
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 2400, len(seen))
+
+	v1, _ := uuid.NewV1()
+	v4, _ := uuid.NewV4()
+	v7, _ := uuid.NewV7()
+	assert.Equal(t, uuid.TimebasedVer1, v1.Version())
+	assert.Equal(t, uuid.RandomlyGeneratedVer4, v4.Version())
+	assert.Equal(t, uuid.TimebasedVer7, v7.Version())
+
+	next, _ := uuid.NewV1()
+	assert.True(t, next.Time100Nanos() > v1.Time100Nanos())
+}
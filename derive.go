@@ -0,0 +1,55 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+/**
+	Derives stable ID of the sub-resource from the parent ID and the qualifier, e.g. the name of the child
+
+    Version 5 UUID in the namespace of the parent, so the creation is idempotent without storing the mapping
+    and any RFC 4122 implementation computes the same ID
+ */
+
+func Derive(parent UUID, qualifier []byte) UUID {
+	return NewSHA1(parent, qualifier)
+}
+
+/**
+	Derives ID of the nested sub-resource applying Derive for every qualifier in order
+ */
+
+func DerivePath(parent UUID, qualifiers ...[]byte) UUID {
+	for _, qualifier := range qualifiers {
+		parent = Derive(parent, qualifier)
+	}
+	return parent
+}
+
+/**
+	Derives version 8 UUID from SHA-256 of the parent and the qualifier
+
+    Same input as Derive with the stronger digest, the layout of RFC 9562 appendix B.2
+ */
+
+func DeriveV8(parent UUID, qualifier []byte) UUID {
+
+	var ns [16]byte
+	parent.MarshalBinaryTo(ns[:])
+
+	h := sha256.New()
+	h.Write(ns[:])
+	h.Write(qualifier)
+	sum := h.Sum(nil)
+
+	var uuid UUID
+	uuid.MostSigBits = (binary.BigEndian.Uint64(sum) &^ versionMask) | v8VersionBits
+	uuid.LeastSigBits = (binary.BigEndian.Uint64(sum[8:]) & counterMask) | variantIETFBits
+	return uuid
+}
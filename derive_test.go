@@ -0,0 +1,31 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDerive(t *testing.T) {
+
+	// RFC 9562 appendix A.4
+	child := uuid.Derive(uuid.NamespaceDNS, []byte("www.example.com"))
+	assert.Equal(t, "2ed6657d-e927-568b-95e1-2665a8aea6a2", child.String())
+	assert.Equal(t, child, uuid.Derive(uuid.NamespaceDNS, []byte("www.example.com")))
+	assert.NotEqual(t, child, uuid.Derive(uuid.NamespaceURL, []byte("www.example.com")))
+
+	assert.Equal(t, uuid.Derive(child, []byte("b")), uuid.DerivePath(uuid.NamespaceDNS, []byte("www.example.com"), []byte("b")))
+	assert.Equal(t, uuid.NamespaceDNS, uuid.DerivePath(uuid.NamespaceDNS))
+
+	// RFC 9562 appendix B.2
+	v8 := uuid.DeriveV8(uuid.NamespaceDNS, []byte("www.example.com"))
+	assert.Equal(t, "5c146b14-3c52-8afd-938a-375d0df1fbf6", v8.String())
+	assert.Equal(t, uuid.CustomVer8, v8.Version())
+	assert.Equal(t, uuid.IETF, v8.Variant())
+}
@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"strings"
+	"sync/atomic"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Partial display of UUIDs in logs and UIs, recognizable but not replayable fragments of sensitive IDs
+
+    Prefix and Suffix count characters of the canonical form, the whole form is shown if they cover it
+ */
+
+type DisplayPolicy struct {
+	Prefix   int
+	Suffix   int
+	Ellipsis string
+}
+
+var (
+
+	/**
+		Canonical form, default
+	 */
+
+	DisplayFull = DisplayPolicy{Prefix: 36}
+
+	/**
+		First 8 hex digits, e.g. 534b44a1
+	 */
+
+	DisplayShort = DisplayPolicy{Prefix: 8}
+
+	/**
+		First and last groups, e.g. 534b44a1-…-572f
+	 */
+
+	DisplayRedacted = DisplayPolicy{Prefix: 8, Suffix: 4, Ellipsis: "-…-"}
+)
+
+var displayPolicy atomic.Value
+
+func init() {
+	displayPolicy.Store(&DisplayFull)
+}
+
+/**
+	Sets display policy used by UUID.Display, returns the previous one
+ */
+
+func SetDisplayPolicy(p DisplayPolicy) DisplayPolicy {
+	return *displayPolicy.Swap(&p).(*DisplayPolicy)
+}
+
+/**
+	Gets display policy used by UUID.Display
+ */
+
+func GetDisplayPolicy() DisplayPolicy {
+	return *displayPolicy.Load().(*DisplayPolicy)
+}
+
+/**
+	Parses policy name: full, short or redacted
+ */
+
+func ParseDisplayPolicy(s string) (DisplayPolicy, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "full":
+		return DisplayFull, nil
+	case "short":
+		return DisplayShort, nil
+	case "redacted":
+		return DisplayRedacted, nil
+	default:
+		return DisplayFull, errors.Errorf("unknown display policy: %q", s)
+	}
+}
+
+/**
+	Formats UUID according to the policy
+ */
+
+func (p DisplayPolicy) Format(id UUID) string {
+	s := id.String()
+	prefix, suffix := clampDisplay(p.Prefix), clampDisplay(p.Suffix)
+	if prefix+suffix >= len(s) {
+		return s
+	}
+	return s[:prefix] + p.Ellipsis + s[len(s)-suffix:]
+}
+
+func clampDisplay(n int) int {
+	switch {
+	case n < 0:
+		return 0
+	case n > 36:
+		return 36
+	default:
+		return n
+	}
+}
+
+/**
+	Gets first 8 hex digits
+ */
+
+func (this UUID) Short() string {
+	return DisplayShort.Format(this)
+}
+
+/**
+	Gets first and last groups, e.g. 534b44a1-…-572f
+ */
+
+func (this UUID) Redacted() string {
+	return DisplayRedacted.Format(this)
+}
+
+/**
+	Gets UUID formatted by the process-wide display policy, set once at startup from configuration
+ */
+
+func (this UUID) Display() string {
+	return GetDisplayPolicy().Format(this)
+}
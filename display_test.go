@@ -0,0 +1,39 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisplay(t *testing.T) {
+
+	id := uuid.MustParse("534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+
+	assert.Equal(t, "534b44a1", id.Short())
+	assert.Equal(t, "534b44a1-…-572f", id.Redacted())
+	assert.Equal(t, id.String(), id.Display())
+
+	prev := uuid.SetDisplayPolicy(uuid.DisplayRedacted)
+	defer uuid.SetDisplayPolicy(prev)
+	assert.Equal(t, uuid.DisplayFull, prev)
+	assert.Equal(t, "534b44a1-…-572f", id.Display())
+	assert.Equal(t, uuid.DisplayRedacted, uuid.GetDisplayPolicy())
+
+	assert.Equal(t, "534b…572f", uuid.DisplayPolicy{Prefix: 4, Suffix: 4, Ellipsis: "…"}.Format(id))
+	assert.Equal(t, "***572f", uuid.DisplayPolicy{Suffix: 4, Ellipsis: "***"}.Format(id))
+	assert.Equal(t, id.String(), uuid.DisplayPolicy{Prefix: 20, Suffix: 20, Ellipsis: "…"}.Format(id))
+	assert.Equal(t, "…", uuid.DisplayPolicy{Prefix: -1, Ellipsis: "…"}.Format(id))
+
+	p, err := uuid.ParseDisplayPolicy("Short")
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.DisplayShort, p)
+	_, err = uuid.ParseDisplayPolicy("none")
+	assert.Error(t, err)
+}
@@ -0,0 +1,393 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/bits"
+	"strings"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+const (
+	base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	ulidAlphabet   = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+	base62Len = 22
+	ulidLen   = 26
+)
+
+var (
+	base62Index = buildIndex(base62Alphabet, false)
+	base58Index = buildIndex(base58Alphabet, false)
+	ulidIndex   = buildIndex(ulidAlphabet, true)
+)
+
+func buildIndex(alphabet string, ignoreCase bool) (index [256]int8) {
+	for i := range index {
+		index[i] = -1
+	}
+	for i := 0; i < len(alphabet); i++ {
+		c := alphabet[i]
+		index[c] = int8(i)
+		if ignoreCase && c >= 'A' && c <= 'Z' {
+			index[c+'a'-'A'] = int8(i)
+		}
+	}
+	return index
+}
+
+/**
+	Computes (hi, lo) * base + digit, returns false on 128-bit overflow
+ */
+
+func mulAdd128(hi, lo, base, digit uint64) (uint64, uint64, bool) {
+	overflow, h := bits.Mul64(hi, base)
+	carry, l := bits.Mul64(lo, base)
+	var c uint64
+	l, c = bits.Add64(l, digit, 0)
+	h, overflow2 := bits.Add64(h, carry, c)
+	return h, l, overflow == 0 && overflow2 == 0
+}
+
+/**
+	Gets 32 hex digits without hyphens
+ */
+
+func (this UUID) Hex() string {
+	data, _ := this.MarshalBinary()
+	return hex.EncodeToString(data)
+}
+
+/**
+	Parses 32 hex digits without hyphens
+ */
+
+func ParseHex(s string) (uuid UUID, err error) {
+	if len(s) != 32 {
+		return Empty, ErrorWrongLen
+	}
+	var data [16]byte
+	if _, err := hex.Decode(data[:], []byte(s)); err != nil {
+		return Empty, errors.Errorf("invalid hex UUID %q: %v", s, err)
+	}
+	err = uuid.UnmarshalBinary(data[:])
+	return uuid, err
+}
+
+/**
+	Gets standard base64 encoding with padding, 24 characters
+ */
+
+func (this UUID) Base64() string {
+	data, _ := this.MarshalBinary()
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+/**
+	Parses standard base64 encoding with padding
+ */
+
+func ParseBase64(s string) (UUID, error) {
+	return decodeBase64(base64.StdEncoding, s)
+}
+
+/**
+	Gets URL-safe base64 encoding without padding, 22 characters
+ */
+
+func (this UUID) Base64URL() string {
+	data, _ := this.MarshalBinary()
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+/**
+	Parses URL-safe base64 encoding without padding
+ */
+
+func ParseBase64URL(s string) (UUID, error) {
+	return decodeBase64(base64.RawURLEncoding, s)
+}
+
+func decodeBase64(enc *base64.Encoding, s string) (uuid UUID, err error) {
+	if len(s) != enc.EncodedLen(16) {
+		return Empty, ErrorWrongLen
+	}
+	var data [16]byte
+	if _, err := enc.Decode(data[:], []byte(s)); err != nil {
+		return Empty, errors.Errorf("invalid base64 UUID %q: %v", s, err)
+	}
+	err = uuid.UnmarshalBinary(data[:])
+	return uuid, err
+}
+
+/**
+	Gets fixed-width base62 encoding, 22 alphanumeric characters
+
+    Fixed width keeps lexicographical order of the strings the same as of the UUIDs
+ */
+
+func (this UUID) Base62() string {
+
+	var dst [base62Len]byte
+
+	hi, lo := this.MostSigBits, this.LeastSigBits
+	for i := base62Len - 1; i >= 0; i-- {
+		var rem uint64
+		hi, rem = bits.Div64(0, hi, 62)
+		lo, rem = bits.Div64(rem, lo, 62)
+		dst[i] = base62Alphabet[rem]
+	}
+
+	return string(dst[:])
+}
+
+/**
+	Parses fixed-width base62 encoding
+ */
+
+func ParseBase62(s string) (UUID, error) {
+
+	if len(s) != base62Len {
+		return Empty, ErrorWrongLen
+	}
+
+	var hi, lo uint64
+	for i := 0; i < base62Len; i++ {
+		digit := base62Index[s[i]]
+		if digit < 0 {
+			return Empty, errors.Errorf("invalid base62 character %q in %q", s[i], s)
+		}
+		var ok bool
+		if hi, lo, ok = mulAdd128(hi, lo, 62, uint64(digit)); !ok {
+			return Empty, errors.Errorf("base62 value overflows 128 bits: %q", s)
+		}
+	}
+
+	return UUID{MostSigBits: hi, LeastSigBits: lo}, nil
+}
+
+/**
+	Gets base58 encoding with the Bitcoin alphabet
+
+    Leading zero bytes are encoded as '1' like in Bitcoin, so the length is up to 22 characters
+ */
+
+func (this UUID) Base58() string {
+
+	var dst [22 + 16]byte
+	i := len(dst)
+
+	hi, lo := this.MostSigBits, this.LeastSigBits
+	for hi != 0 || lo != 0 {
+		var rem uint64
+		hi, rem = bits.Div64(0, hi, 58)
+		lo, rem = bits.Div64(rem, lo, 58)
+		i--
+		dst[i] = base58Alphabet[rem]
+	}
+
+	data, _ := this.MarshalBinary()
+	for _, b := range data {
+		if b != 0 {
+			break
+		}
+		i--
+		dst[i] = base58Alphabet[0]
+	}
+
+	return string(dst[i:])
+}
+
+/**
+	Parses base58 encoding with the Bitcoin alphabet
+ */
+
+func ParseBase58(s string) (UUID, error) {
+
+	if len(s) == 0 || len(s) > 22+16 {
+		return Empty, ErrorWrongLen
+	}
+
+	var hi, lo uint64
+	for i := 0; i < len(s); i++ {
+		digit := base58Index[s[i]]
+		if digit < 0 {
+			return Empty, errors.Errorf("invalid base58 character %q in %q", s[i], s)
+		}
+		var ok bool
+		if hi, lo, ok = mulAdd128(hi, lo, 58, uint64(digit)); !ok {
+			return Empty, errors.Errorf("base58 value overflows 128 bits: %q", s)
+		}
+	}
+
+	return UUID{MostSigBits: hi, LeastSigBits: lo}, nil
+}
+
+/**
+	Gets ULID string representation, 26 characters of Crockford's base32
+
+    The UUID bits are kept as is, so only v7 and ULID-born identifiers have meaningful ULID timestamps
+ */
+
+func (this UUID) ULID() string {
+
+	var dst [ulidLen]byte
+
+	hi, lo := this.MostSigBits, this.LeastSigBits
+	for i := ulidLen - 1; i >= 0; i-- {
+		dst[i] = ulidAlphabet[lo&0x1F]
+		lo = (lo >> 5) | (hi << 59)
+		hi >>= 5
+	}
+
+	return string(dst[:])
+}
+
+/**
+	Parses ULID string representation, case insensitive
+ */
+
+func ParseULID(s string) (UUID, error) {
+
+	if len(s) != ulidLen {
+		return Empty, ErrorWrongLen
+	}
+
+	if ulidIndex[s[0]] > 7 {
+		return Empty, errors.Errorf("ULID value overflows 128 bits: %q", s)
+	}
+
+	var hi, lo uint64
+	for i := 0; i < ulidLen; i++ {
+		digit := ulidIndex[s[i]]
+		if digit < 0 {
+			return Empty, errors.Errorf("invalid ULID character %q in %q", s[i], s)
+		}
+		hi = (hi << 5) | (lo >> 59)
+		lo = (lo << 5) | uint64(digit)
+	}
+
+	return UUID{MostSigBits: hi, LeastSigBits: lo}, nil
+}
+
+/**
+	Representation of UUID, reported by ParseAny and selected by configuration for Encode and Decode
+ */
+
+type Encoding int
+
+const (
+
+	/**
+		Canonical form xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+	 */
+
+	EncodingCanonical Encoding = iota
+
+	/**
+		32 hex digits without hyphens
+	 */
+
+	EncodingHex32
+
+	/**
+		Canonical form in curly braces
+	 */
+
+	EncodingBraced
+
+	/**
+		Canonical form with urn:uuid: prefix
+	 */
+
+	EncodingURN
+
+	/**
+		URL-safe base64 without padding
+	 */
+
+	EncodingBase64URL
+
+	/**
+		Base58 with the Bitcoin alphabet
+	 */
+
+	EncodingBase58
+
+	/**
+		Crockford's base32 of ULID
+	 */
+
+	EncodingULID
+
+	/**
+		Standard base64 with padding
+	 */
+
+	EncodingBase64
+
+	/**
+		Fixed-width base62
+	 */
+
+	EncodingBase62
+
+	/**
+		C initializer of GUID struct
+	 */
+
+	EncodingGUIDInitializer
+
+	/**
+		16 bytes in big-endian order, MarshalBinary
+	 */
+
+	EncodingBinaryBE
+
+	/**
+		16 bytes of System.Guid with the first three groups in little-endian order
+	 */
+
+	EncodingBinaryLE
+
+	/**
+		17 bytes of the self-describing sortable format, MarshalSortableBinaryV2
+	 */
+
+	EncodingSortableBinary
+)
+
+var encodingNames = []string{"canonical", "hex32", "braced", "urn", "base64url", "base58", "ulid",
+	"base64", "base62", "guid", "binarybe", "binaryle", "sortablebinary"}
+
+func (e Encoding) String() string {
+	if e >= 0 && int(e) < len(encodingNames) {
+		return encodingNames[e]
+	}
+	return fmt.Sprintf("Encoding(%d)", int(e))
+}
+
+/**
+	Parses encoding name, e.g. canonical, hex32 or base64url, canonical if empty
+ */
+
+func ParseEncoding(s string) (Encoding, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return EncodingCanonical, nil
+	}
+	for i, name := range encodingNames {
+		if s == name {
+			return Encoding(i), nil
+		}
+	}
+	return EncodingCanonical, errors.Errorf("unknown encoding: %q", s)
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodings(t *testing.T) {
+
+	id, err := uuid.Parse("534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+	assert.NoError(t, err)
+
+	assert.Equal(t, "534b44a19bf13d20b71ecc4eb77c572f", id.Hex())
+	assert.Equal(t, "U0tEoZvxPSC3HsxOt3xXLw==", id.Base64())
+	assert.Equal(t, "U0tEoZvxPSC3HsxOt3xXLw", id.Base64URL())
+	assert.Equal(t, "BHZRWp4JQS8cYJvjRB6pEz", id.Base58())
+	assert.Equal(t, "2K9D2A36ZH7MGBE7PC9TVQRNSF", id.ULID())
+
+	assert.Equal(t, "1111111111111111", uuid.Empty.Base58())
+	assert.Equal(t, "1111111111111112", uuid.Create(0, 1).Base58())
+
+	max := uuid.Create(-1, -1)
+	assert.Equal(t, "7ZZZZZZZZZZZZZZZZZZZZZZZZZ", max.ULID())
+	assert.Equal(t, "YcVfxkQb6JRzqk5kF2tNLv", max.Base58())
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		id := uuid.Create(r.Int63()<<uint(r.Intn(2)), r.Int63()>>uint(r.Intn(64)))
+		assertDecode(t, id, id.Hex(), uuid.ParseHex)
+		assertDecode(t, id, id.Base64(), uuid.ParseBase64)
+		assertDecode(t, id, id.Base64URL(), uuid.ParseBase64URL)
+		assertDecode(t, id, id.Base58(), uuid.ParseBase58)
+		assertDecode(t, id, id.ULID(), uuid.ParseULID)
+	}
+
+	_, err = uuid.ParseULID("8ZZZZZZZZZZZZZZZZZZZZZZZZZ")
+	assert.Error(t, err)
+
+	_, err = uuid.ParseBase58("zzzzzzzzzzzzzzzzzzzzzzz")
+	assert.Error(t, err)
+
+	_, err = uuid.ParseBase58("0OIl")
+	assert.Error(t, err)
+
+	_, err = uuid.ParseHex("534b44a19bf13d20b71ecc4eb77c572g")
+	assert.Error(t, err)
+
+	parsed, err := uuid.ParseULID("2k9d2a36zh7mgbe7pc9tvqrnsf")
+	assert.NoError(t, err)
+	assert.True(t, id.Equal(parsed))
+
+}
+
+func assertDecode(t *testing.T, expected uuid.UUID, s string, decode func(string) (uuid.UUID, error)) {
+	actual, err := decode(s)
+	if err != nil {
+		t.Fatal("fail to decode ", s, err)
+	}
+	assert.True(t, expected.Equal(actual), s)
+}
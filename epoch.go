@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"math"
+	"time"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Compact timestamp of version 8 UUID counted from the custom epoch
+
+	msb: timestamp in the leftmost bits + free bits + 4-bit version + 12 free bits
+	lsb: 2-bit variant + 62 free bits
+
+    Free bits are left for the application sequence or shard fields.
+    For example 32 bits of seconds since 2020-01-01 last until 2156, 40 bits of millis until 2054.
+ */
+
+type TimeLayout struct {
+	epoch     time.Time
+	precision time.Duration
+	bits      uint
+}
+
+/**
+	Creates layout of the timestamp with the epoch, precision of one tick and width in bits up to 48
+ */
+
+func NewTimeLayout(epoch time.Time, precision time.Duration, bits uint) (TimeLayout, error) {
+	if epoch.IsZero() {
+		return TimeLayout{}, errors.New("epoch is not set")
+	}
+	if precision <= 0 {
+		return TimeLayout{}, errors.Errorf("invalid precision %v", precision)
+	}
+	if bits < 1 || bits > 48 {
+		return TimeLayout{}, errors.Errorf("timestamp width %d is out of range [1, 48]", bits)
+	}
+	if precision > time.Duration(math.MaxInt64)/time.Duration(1<<bits-1) {
+		return TimeLayout{}, errors.Errorf("range of %d bits with precision %v exceeds 292 years", bits, precision)
+	}
+	return TimeLayout{epoch: epoch, precision: precision, bits: bits}, nil
+}
+
+/**
+	Gets epoch of the layout
+ */
+
+func (l TimeLayout) Epoch() time.Time {
+	return l.epoch
+}
+
+/**
+	Gets duration of one tick
+ */
+
+func (l TimeLayout) Precision() time.Duration {
+	return l.precision
+}
+
+/**
+	Gets width of the timestamp in bits
+ */
+
+func (l TimeLayout) Bits() uint {
+	return l.bits
+}
+
+/**
+	Gets last representable time
+ */
+
+func (l TimeLayout) Max() time.Time {
+	return l.epoch.Add(time.Duration(1<<l.bits-1) * l.precision)
+}
+
+/**
+	Gets number of ticks since epoch, fails if the time is out of range
+ */
+
+func (l TimeLayout) Ticks(t time.Time) (uint64, error) {
+	if t.Before(l.epoch) || t.After(l.Max()) {
+		return 0, errors.Errorf("time %v is out of range [%v, %v]", t, l.epoch, l.Max())
+	}
+	return uint64(t.Sub(l.epoch) / l.precision), nil
+}
+
+/**
+	Writes timestamp into the leftmost bits, sets version 8 and IETF variant, other bits are kept
+ */
+
+func (l TimeLayout) Stamp(uuid *UUID, t time.Time) error {
+	ticks, err := l.Ticks(t)
+	if err != nil {
+		return err
+	}
+	shift := 64 - l.bits
+	uuid.MostSigBits = ticks<<shift | (uuid.MostSigBits & (1<<shift - 1) &^ versionMask) | v8VersionBits
+	uuid.LeastSigBits = (uuid.LeastSigBits & counterMask) | variantIETFBits
+	return nil
+}
+
+/**
+	Gets timestamp of the UUID in the layout, truncated to the precision
+ */
+
+func (l TimeLayout) Time(uuid UUID) time.Time {
+	ticks := uuid.MostSigBits >> (64 - l.bits)
+	return l.epoch.Add(time.Duration(ticks) * l.precision)
+}
+
+/**
+	Generates version 8 UUID with the timestamp and random free bits
+ */
+
+func (l TimeLayout) New(t time.Time) (uuid UUID, err error) {
+	if err := checkFrozen(); err != nil {
+		return Empty, err
+	}
+
+	return minted(rerollReserved(func() (uuid UUID, err error) {
+		var randomBytes [16]byte
+		if _, err := io.ReadFull(rand.Reader, randomBytes[:]); err != nil {
+			return Empty, errors.Wrap(err, "read entropy")
+		}
+		uuid.MostSigBits = binary.BigEndian.Uint64(randomBytes[:8])
+		uuid.LeastSigBits = binary.BigEndian.Uint64(randomBytes[8:])
+		err = l.Stamp(&uuid, t)
+		return uuid, err
+	}))
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeLayout(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	epoch := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	layout, err := uuid.NewTimeLayout(epoch, time.Second, 32)
+	assert.NoError(t, err)
+	assert.Equal(t, 2156, layout.Max().Year())
+
+	now := time.Date(2023, 5, 1, 10, 20, 30, 999, time.UTC)
+
+	id := uuid.UUID{MostSigBits: 0x1234, LeastSigBits: 0xFFFFFFFFFFFFFFFF}
+	assert.NoError(t, layout.Stamp(&id, now))
+	assert.Equal(t, uuid.CustomVer8, id.Version())
+	assert.Equal(t, uuid.IETF, id.Variant())
+	assert.Equal(t, uint64(0x0234), id.MostSigBits&0x0FFF)
+	assert.Equal(t, now.Truncate(time.Second), layout.Time(id))
+
+	ticks, err := layout.Ticks(now)
+	assert.NoError(t, err)
+	assert.Equal(t, ticks, id.MostSigBits>>32)
+
+	_, err = layout.Ticks(epoch.Add(-time.Second))
+	assert.Error(t, err)
+	assert.Error(t, layout.Stamp(&id, layout.Max().Add(time.Second)))
+
+	layout, err = uuid.NewTimeLayout(epoch, time.Millisecond, 40)
+	assert.NoError(t, err)
+	a, err := layout.New(now)
+	assert.NoError(t, err)
+	b, err := layout.New(now.Add(time.Millisecond))
+	assert.NoError(t, err)
+	assert.Equal(t, -1, uuid.ComparePostgres(a, b))
+	assert.Equal(t, now.Truncate(time.Millisecond), layout.Time(a))
+
+	_, err = uuid.NewTimeLayout(epoch, time.Second, 49)
+	assert.Error(t, err)
+	_, err = uuid.NewTimeLayout(epoch, time.Second, 48)
+	assert.Error(t, err)
+	_, err = uuid.NewTimeLayout(epoch, 0, 32)
+	assert.Error(t, err)
+	_, err = uuid.NewTimeLayout(time.Time{}, time.Second, 32)
+	assert.Error(t, err)
+
+}
@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Layout of expiring version 8 UUID
+
+	msb: 48-bit issue time in unix millis + 4-bit version + 12 high bits of TTL
+	lsb: 2-bit variant + 12-bit layout marker + 20 low bits of TTL + 30-bit random
+
+    TTL is stored in seconds as 32-bit unsigned value. The marker tells expiring UUIDs apart
+    from the other version 8 layouts, a foreign version 8 UUID carries it with probability 1/4096.
+ */
+
+const (
+	v8VersionBits = uint64(0x0000000000008000)
+
+	v8LayoutShift = 50
+	v8LayoutMask  = uint64(0xFFF) << v8LayoutShift
+
+	v8LayoutExpiring = uint64(0xE59)
+
+	maxExpiringTTL = time.Duration(0xFFFFFFFF) * time.Second
+
+	expiringTTLShift   = 30
+	expiringRandomMask = uint64(0x000000003FFFFFFF)
+)
+
+/**
+	Checks version, variant and layout marker of version 8 UUID
+ */
+
+func hasV8Layout(id UUID, layout uint64) bool {
+	return id.Version() == CustomVer8 && id.Variant() == IETF && (id.LeastSigBits&v8LayoutMask)>>v8LayoutShift == layout
+}
+
+/**
+	Generates version 8 UUID carrying issue time and TTL, so it can be validated without the datastore lookup
+
+    TTL is rounded up to seconds and must be in range [1s, 136 years]. The UUID is not tamper-proof,
+    anyone can mint one with the later expiration, so UUIDs received from untrusted clients
+    are signed by Signer and checked by Signer.Verify before Expired is trusted.
+ */
+
+func NewExpiring(ttl time.Duration) (UUID, error) {
+	return newExpiring(time.Now(), ttl, rand.Reader)
+}
+
+func newExpiring(now time.Time, ttl time.Duration, reader io.Reader) (uuid UUID, err error) {
+
+	if err := checkFrozen(); err != nil {
+		return Empty, err
+	}
+
+	if ttl <= 0 || ttl > maxExpiringTTL {
+		return Empty, errors.Errorf("TTL %v is out of range", ttl)
+	}
+
+	ttlSeconds := uint64((ttl + time.Second - 1) / time.Second)
+
+	millis := uint64(now.UnixNano()/int64(time.Millisecond)) & 0xFFFFFFFFFFFF
+
+	return minted(rerollReserved(func() (uuid UUID, err error) {
+		var randomBytes [8]byte
+		if _, err := io.ReadFull(reader, randomBytes[:]); err != nil {
+			return Empty, errors.Wrap(err, "read entropy")
+		}
+		uuid.MostSigBits = (millis << 16) | v8VersionBits | (ttlSeconds >> 20)
+		uuid.LeastSigBits = variantIETFBits | v8LayoutExpiring<<v8LayoutShift | ((ttlSeconds & 0xFFFFF) << expiringTTLShift) |
+			(binary.BigEndian.Uint64(randomBytes[:]) & expiringRandomMask)
+		return uuid, nil
+	}))
+}
+
+/**
+	Checks if the UUID has the layout of NewExpiring
+ */
+
+func (this UUID) IsExpiring() bool {
+	return hasV8Layout(this, v8LayoutExpiring)
+}
+
+/**
+	Gets issue time of the expiring UUID, zero time for other UUIDs
+ */
+
+func (this UUID) IssuedAt() time.Time {
+	if !this.IsExpiring() {
+		return time.Time{}
+	}
+	millis := int64(this.MostSigBits >> 16)
+	return time.Unix(millis/1000, (millis%1000)*int64(time.Millisecond))
+}
+
+/**
+	Gets TTL of the expiring UUID, zero for other UUIDs
+ */
+
+func (this UUID) TTL() time.Duration {
+	if !this.IsExpiring() {
+		return 0
+	}
+	ttlSeconds := ((this.MostSigBits & 0xFFF) << 20) | ((this.LeastSigBits >> expiringTTLShift) & 0xFFFFF)
+	return time.Duration(ttlSeconds) * time.Second
+}
+
+/**
+	Gets expiration time of the expiring UUID, zero time for other UUIDs
+ */
+
+func (this UUID) ExpiresAt() time.Time {
+	if !this.IsExpiring() {
+		return time.Time{}
+	}
+	return this.IssuedAt().Add(this.TTL())
+}
+
+/**
+	Checks if the expiring UUID is expired at the specific time
+
+    Other UUIDs, including version 8 UUIDs without the expiring layout marker, are always expired.
+    Only the layout is checked, see NewExpiring about Signer for the UUIDs of untrusted origin.
+ */
+
+func (this UUID) Expired(now time.Time) bool {
+	if !this.IsExpiring() {
+		return true
+	}
+	return !now.Before(this.ExpiresAt())
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpiring(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	_, err := uuid.NewExpiring(0)
+	assert.Error(t, err)
+
+	before := time.Now().Truncate(time.Millisecond)
+
+	id, err := uuid.NewExpiring(90 * time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.CustomVer8, id.Version())
+	assert.Equal(t, uuid.IETF, id.Variant())
+	assert.True(t, id.IsExpiring())
+	assert.Equal(t, 90*time.Minute, id.TTL())
+
+	issuedAt := id.IssuedAt()
+	assert.False(t, issuedAt.Before(before))
+	assert.True(t, issuedAt.Before(before.Add(time.Second)))
+
+	assert.Equal(t, issuedAt.Add(90*time.Minute), id.ExpiresAt())
+	assert.False(t, id.Expired(issuedAt))
+	assert.False(t, id.Expired(issuedAt.Add(89*time.Minute)))
+	assert.True(t, id.Expired(issuedAt.Add(90*time.Minute)))
+
+	// sub-second TTL is rounded up
+	id, err = uuid.NewExpiring(time.Millisecond)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Second, id.TTL())
+
+	// large TTL uses both TTL fields
+	id, err = uuid.NewExpiring(time.Duration(0xFFFFFFFF) * time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0xFFFFFFFF)*time.Second, id.TTL())
+	assert.Equal(t, uuid.IETF, id.Variant())
+
+	random, _ := uuid.RandomUUID()
+	assert.True(t, random.Expired(time.Now()))
+	assert.True(t, random.ExpiresAt().IsZero())
+
+	derived, err := uuid.Parse("e8e0dc8d-0c5a-8b5e-9f3c-5d6b2b0b3a4f")
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.CustomVer8, derived.Version())
+	assert.False(t, derived.IsExpiring())
+	assert.True(t, derived.Expired(time.Now()))
+	assert.True(t, derived.ExpiresAt().IsZero())
+	assert.True(t, derived.IssuedAt().IsZero())
+	assert.Equal(t, time.Duration(0), derived.TTL())
+
+}
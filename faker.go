@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"io"
+	"math/rand"
+	"reflect"
+	"sync"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+var uuidType = reflect.TypeOf(Empty)
+
+/**
+	Provider function compatible with go-faker and bxcodec faker AddProvider, produces random version 4 UUIDs
+
+    Register it with faker.AddProvider("uuid", uuid.FakerProvider) and tag fields with `faker:"uuid"`
+ */
+
+func FakerProvider(v reflect.Value) (interface{}, error) {
+	return providerValue(v, defaultRandomGenerator)
+}
+
+/**
+	Creates faker provider producing reproducible version 4 UUIDs for the seed
+ */
+
+func SeededFakerProvider(seed int64) func(v reflect.Value) (interface{}, error) {
+	gen := NewSeededGenerator(seed)
+	return func(v reflect.Value) (interface{}, error) {
+		return providerValue(v, gen)
+	}
+}
+
+/**
+	Creates generator of version 4 UUIDs from math/rand source with the seed
+
+    Not suitable for security purposes, intended only for fixtures and tests
+ */
+
+func NewSeededGenerator(seed int64) Generator {
+	return &RandomGenerator{Reader: &lockedReader{r: rand.New(rand.NewSource(seed))}}
+}
+
+/**
+	Fills all UUID and *UUID fields of the struct recursively with UUIDs from the generator
+
+    Non-zero fields are kept as is, values reachable by several pointers or slices are filled once,
+    so cyclic structures like doubly linked lists are supported
+ */
+
+func FillUUIDs(ptr interface{}, gen Generator) error {
+	v := reflect.ValueOf(ptr)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return errors.Errorf("pointer to struct expected, got %T", ptr)
+	}
+	return fillValue(v, gen, make(map[fillVisit]bool))
+}
+
+/**
+	Pointer or slice already walked by fillValue, the type and length tell apart values sharing the address
+ */
+
+type fillVisit struct {
+	ptr uintptr
+	typ reflect.Type
+	len int
+}
+
+var defaultRandomGenerator = NewRandomGenerator()
+
+func providerValue(v reflect.Value, gen Generator) (interface{}, error) {
+	id, err := gen.Next()
+	if err != nil {
+		return nil, err
+	}
+	if v.IsValid() && v.Type() == reflect.PtrTo(uuidType) {
+		return &id, nil
+	}
+	return id, nil
+}
+
+func fillValue(v reflect.Value, gen Generator, visited map[fillVisit]bool) error {
+
+	switch {
+
+	case v.Type() == uuidType:
+		if v.CanSet() && v.Interface().(UUID) == Empty {
+			id, err := gen.Next()
+			if err != nil {
+				return err
+			}
+			v.Set(reflect.ValueOf(id))
+		}
+
+	case v.Kind() == reflect.Ptr && v.Type().Elem() == uuidType:
+		if v.CanSet() && v.IsNil() {
+			id, err := gen.Next()
+			if err != nil {
+				return err
+			}
+			v.Set(reflect.ValueOf(&id))
+		}
+
+	case v.Kind() == reflect.Ptr && !v.IsNil():
+		visit := fillVisit{ptr: v.Pointer(), typ: v.Type()}
+		if visited[visit] {
+			return nil
+		}
+		visited[visit] = true
+		return fillValue(v.Elem(), gen, visited)
+
+	case v.Kind() == reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if err := fillValue(v.Field(i), gen, visited); err != nil {
+				return err
+			}
+		}
+
+	case v.Kind() == reflect.Slice || v.Kind() == reflect.Array:
+		if v.Kind() == reflect.Slice {
+			visit := fillVisit{ptr: v.Pointer(), typ: v.Type(), len: v.Len()}
+			if visited[visit] {
+				return nil
+			}
+			visited[visit] = true
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := fillValue(v.Index(i), gen, visited); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+type lockedReader struct {
+	sync.Mutex
+	r io.Reader
+}
+
+func (this *lockedReader) Read(p []byte) (int, error) {
+	this.Lock()
+	defer this.Unlock()
+	return this.r.Read(p)
+}
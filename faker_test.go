@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type fixture struct {
+	ID       uuid.UUID
+	ParentID *uuid.UUID
+	Kept     uuid.UUID
+	Items    []fixtureItem
+	hidden   uuid.UUID
+}
+
+type fixtureItem struct {
+	ID uuid.UUID
+}
+
+func TestFakerProvider(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	value, err := uuid.FakerProvider(reflect.ValueOf(uuid.Empty))
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.RandomlyGeneratedVer4, value.(uuid.UUID).Version())
+
+	var ptr *uuid.UUID
+	value, err = uuid.FakerProvider(reflect.ValueOf(ptr))
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.RandomlyGeneratedVer4, value.(*uuid.UUID).Version())
+
+	first, _ := uuid.SeededFakerProvider(42)(reflect.Value{})
+	second, _ := uuid.SeededFakerProvider(42)(reflect.Value{})
+	assert.Equal(t, first, second)
+
+}
+
+func TestFillUUIDs(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	kept := uuid.Create(1, 2)
+	f := fixture{Kept: kept, Items: make([]fixtureItem, 3)}
+
+	assert.NoError(t, uuid.FillUUIDs(&f, uuid.NewSeededGenerator(1)))
+	assert.Equal(t, uuid.RandomlyGeneratedVer4, f.ID.Version())
+	assert.NotNil(t, f.ParentID)
+	assert.Equal(t, kept, f.Kept)
+	assert.Equal(t, uuid.Empty, f.hidden)
+	for _, item := range f.Items {
+		assert.Equal(t, uuid.RandomlyGeneratedVer4, item.ID.Version())
+	}
+
+	g := fixture{Kept: kept, Items: make([]fixtureItem, 3)}
+	assert.NoError(t, uuid.FillUUIDs(&g, uuid.NewSeededGenerator(1)))
+	assert.Equal(t, f.ID, g.ID)
+	assert.Equal(t, f.Items, g.Items)
+
+	assert.Error(t, uuid.FillUUIDs(f, uuid.NewRandomGenerator()))
+
+}
+
+type fixtureNode struct {
+	ID       uuid.UUID
+	Next     *fixtureNode
+	Prev     *fixtureNode
+	Children []fixtureNode
+}
+
+func TestFillUUIDsCycle(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	first := &fixtureNode{}
+	second := &fixtureNode{Prev: first, Next: first}
+	first.Next, first.Prev = second, second
+	first.Children = make([]fixtureNode, 2)
+	first.Children[0].Children = first.Children
+
+	assert.NoError(t, uuid.FillUUIDs(first, uuid.NewSeededGenerator(1)))
+	assert.Equal(t, uuid.RandomlyGeneratedVer4, first.ID.Version())
+	assert.Equal(t, uuid.RandomlyGeneratedVer4, second.ID.Version())
+	assert.NotEqual(t, first.ID, second.ID)
+	for _, child := range first.Children {
+		assert.Equal(t, uuid.RandomlyGeneratedVer4, child.ID.Version())
+	}
+}
@@ -0,0 +1,19 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd
+
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"os"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+func tryLockFile(file *os.File) (bool, error) {
+	return false, errors.New("advisory file locks are not supported on this platform")
+}
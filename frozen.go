@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"sync/atomic"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+var ErrorFrozen = errors.New("uuid generation is frozen")
+
+var frozen int32
+
+func init() {
+	if frozenBuild {
+		frozen = 1
+	}
+}
+
+/**
+	Switches the process to the read-only mode, e.g. verifier services that must never mint identifiers
+
+    All generators, RandomUUID, GenerateParallel, NewExpiring and TimeLayout.New fail with ErrorFrozen,
+    parsing, formatting and name-based derivation keep working. The switch is one-way,
+    the build tag uuid_frozen freezes the process from the start.
+ */
+
+func Freeze() {
+	atomic.StoreInt32(&frozen, 1)
+}
+
+/**
+	Tells whether generation is frozen
+ */
+
+func Frozen() bool {
+	return atomic.LoadInt32(&frozen) != 0
+}
+
+func checkFrozen() error {
+	if Frozen() {
+		return ErrorFrozen
+	}
+	return nil
+}
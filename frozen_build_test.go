@@ -0,0 +1,30 @@
+//go:build uuid_frozen
+// +build uuid_frozen
+
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFrozenBuild(t *testing.T) {
+
+	assert.True(t, uuid.Frozen())
+
+	_, err := uuid.NewV7()
+	assert.Equal(t, uuid.ErrorFrozen, err)
+	_, err = uuid.RandomUUID()
+	assert.Equal(t, uuid.ErrorFrozen, err)
+
+	id, err := uuid.Parse("017f22e2-79b0-7cc3-98c4-dc0c0c07398f")
+	assert.NoError(t, err)
+	assert.Equal(t, "017f22e2-79b0-7cc3-98c4-dc0c0c07398f", id.String())
+}
@@ -0,0 +1,11 @@
+//go:build !uuid_frozen
+// +build !uuid_frozen
+
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+const frozenBuild = false
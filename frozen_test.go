@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+/**
+	Freeze is one-way, so the checks run in the child process
+ */
+
+func TestFrozen(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build is frozen from the start")
+	}
+
+	if os.Getenv("UUID_TEST_FROZEN") != "1" {
+		cmd := exec.Command(os.Args[0], "-test.run=^TestFrozen$", "-test.v")
+		cmd.Env = append(os.Environ(), "UUID_TEST_FROZEN=1")
+		out, err := cmd.CombinedOutput()
+		assert.NoError(t, err, string(out))
+		assert.Contains(t, string(out), "--- PASS: TestFrozen")
+		return
+	}
+
+	v7, err := uuid.NewTimeGenerator(uuid.TimebasedVer7)
+	assert.NoError(t, err)
+	_, err = v7.Next()
+	assert.NoError(t, err)
+
+	assert.False(t, uuid.Frozen())
+	uuid.Freeze()
+	assert.True(t, uuid.Frozen())
+
+	_, err = v7.Next()
+	assert.Equal(t, uuid.ErrorFrozen, err)
+	_, err = v7.NextGroup(2)
+	assert.Equal(t, uuid.ErrorFrozen, err)
+	_, err = uuid.NewRandomGenerator().Next()
+	assert.Equal(t, uuid.ErrorFrozen, err)
+	_, err = uuid.RandomUUID()
+	assert.Equal(t, uuid.ErrorFrozen, err)
+	_, err = uuid.NewV4()
+	assert.Equal(t, uuid.ErrorFrozen, err)
+	_, err = uuid.NewV7()
+	assert.Equal(t, uuid.ErrorFrozen, err)
+	_, err = uuid.NewExpiring(time.Hour)
+	assert.Equal(t, uuid.ErrorFrozen, err)
+	_, err = uuid.GenerateParallel(context.Background(), 10, 2)
+	assert.Equal(t, uuid.ErrorFrozen, err)
+	_, err = uuid.NewStreamAllocator().Next("orders")
+	assert.Equal(t, uuid.ErrorFrozen, err)
+	_, err = uuid.NewHLCGenerator().Next()
+	assert.Equal(t, uuid.ErrorFrozen, err)
+	_, err = uuid.NewReplayGenerator(strings.NewReader("017f22e2-79b0-7cc3-98c4-dc0c0c07398f\n")).Next()
+	assert.Equal(t, uuid.ErrorFrozen, err)
+
+	id, err := uuid.Parse("017f22e2-79b0-7cc3-98c4-dc0c0c07398f")
+	assert.NoError(t, err)
+	assert.Equal(t, "017f22e2-79b0-7cc3-98c4-dc0c0c07398f", id.String())
+	assert.Equal(t, uuid.NamebasedVer5, uuid.NewSHA1(uuid.NamespaceURL, []byte("x")).Version())
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"math"
+)
+
+/**
+	Number of payload bits of UUID besides version and variant
+ */
+
+const PayloadBits = 122
+
+/**
+	Coarsens UUID for privacy-preserving analytics by zeroing the low bits of the payload
+
+    Version and variant are kept, bits are counted from the least significant payload bit of rand_b
+    through rand_a to the timestamp, bits out of [0, PayloadBits] are clamped.
+    For time-based version 7 UUIDs generalizing 74 and more bits coarsens the timestamp.
+ */
+
+func (this UUID) Generalize(bits int) UUID {
+	switch {
+	case bits <= 0:
+		return this
+	case bits > PayloadBits:
+		bits = PayloadBits
+	}
+	l, r := scrambleSplit(this)
+	if bits <= 61 {
+		r &^= uint64(1)<<bits - 1
+	} else {
+		r = 0
+		l &^= uint64(1)<<(bits-61) - 1
+	}
+	return scrambleJoin(this, l, r)
+}
+
+/**
+	Gets expected number of random UUIDs sharing one bucket after generalization of the bits
+ */
+
+func ExpectedBucketSize(n int, bits int) float64 {
+	if bits < 0 {
+		bits = 0
+	}
+	if bits > PayloadBits {
+		bits = PayloadBits
+	}
+	return float64(n) / math.Exp2(float64(PayloadBits-bits))
+}
+
+/**
+	Gets the least number of bits to generalize so that n random UUIDs have k per bucket on average
+ */
+
+func GeneralizeBitsFor(n, k int) int {
+	if n <= 0 || k <= 1 {
+		return 0
+	}
+	bits := PayloadBits - int(math.Floor(math.Log2(float64(n)/float64(k))))
+	if bits > PayloadBits {
+		bits = PayloadBits
+	}
+	return bits
+}
+
+/**
+	Gets k-anonymity of the generalized IDs, the size of the smallest bucket, 0 for no IDs
+ */
+
+func KAnonymity(ids []UUID, bits int) int {
+	buckets := make(map[UUID]int)
+	for _, id := range ids {
+		buckets[id.Generalize(bits)]++
+	}
+	k := 0
+	for _, n := range buckets {
+		if k == 0 || n < k {
+			k = n
+		}
+	}
+	return k
+}
@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeneralize(t *testing.T) {
+
+	id := uuid.UUID{MostSigBits: 0xFFFFFFFFFFFF4FFF, LeastSigBits: 0xBFFFFFFFFFFFFFFF}
+
+	assert.Equal(t, id, id.Generalize(0))
+	assert.Equal(t, uuid.UUID{MostSigBits: 0xFFFFFFFFFFFF4FFF, LeastSigBits: 0xBFFFFFFFFFFFFF00}, id.Generalize(8))
+	assert.Equal(t, uuid.UUID{MostSigBits: 0xFFFFFFFFFFFF4FFF, LeastSigBits: 0x8000000000000000}, id.Generalize(62))
+	assert.Equal(t, uuid.UUID{MostSigBits: 0xFFFFFFFFFFFF4F00, LeastSigBits: 0x8000000000000000}, id.Generalize(70))
+	assert.Equal(t, uuid.UUID{MostSigBits: 0xFFFFFFFFFFF04000, LeastSigBits: 0x8000000000000000}, id.Generalize(78))
+	assert.Equal(t, uuid.UUID{MostSigBits: 0x4000, LeastSigBits: 0x8000000000000000}, id.Generalize(uuid.PayloadBits))
+	assert.Equal(t, id.Generalize(uuid.PayloadBits), id.Generalize(200))
+}
+
+func TestKAnonymity(t *testing.T) {
+
+	ids := sampleIDs(t, 4096)
+
+	assert.Equal(t, 1, uuid.KAnonymity(ids, 0))
+	assert.Equal(t, 4096, uuid.KAnonymity(ids, uuid.PayloadBits))
+	assert.Equal(t, 0, uuid.KAnonymity(nil, 10))
+
+	assert.Equal(t, 0, uuid.GeneralizeBitsFor(4096, 1))
+	bits := uuid.GeneralizeBitsFor(4096, 64)
+	assert.Equal(t, uuid.PayloadBits-6, bits)
+	assert.Equal(t, 64.0, uuid.ExpectedBucketSize(4096, bits))
+	assert.True(t, uuid.KAnonymity(ids, bits) > 20)
+}
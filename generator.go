@@ -0,0 +1,432 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Source of new UUIDs
+
+    Implementations must be safe for concurrent use
+ */
+
+type Generator interface {
+	Next() (UUID, error)
+}
+
+/**
+	Creates generator for the specific version
+
+    Supported versions are TimebasedVer1, RandomlyGeneratedVer4 and TimebasedVer7
+ */
+
+func NewGenerator(version Version) (Generator, error) {
+	switch version {
+	case RandomlyGeneratedVer4:
+		return NewRandomGenerator(), nil
+	case TimebasedVer1, TimebasedVer7:
+		return NewTimeGenerator(version)
+	default:
+		return nil, errors.Errorf("unsupported generator version: %v", version)
+	}
+}
+
+/**
+	Generator of version 4 UUIDs
+ */
+
+type RandomGenerator struct {
+
+	/**
+		Source of entropy, crypto/rand by default
+	 */
+
+	Reader io.Reader
+
+	/**
+		Consecutive entropy failures of Next and the last error for the health check
+	 */
+
+	healthLock sync.Mutex
+	failures   int
+	lastError  error
+}
+
+/**
+	Creates generator of version 4 UUIDs backed by crypto/rand
+ */
+
+func NewRandomGenerator() *RandomGenerator {
+	return &RandomGenerator{Reader: rand.Reader}
+}
+
+/**
+	Generates random UUID, UUIDs in the reserved ranges are re-rolled
+
+    Next implements the Generator interface.
+ */
+
+func (this *RandomGenerator) Next() (uuid UUID, err error) {
+
+	if err := checkFrozen(); err != nil {
+		return Empty, err
+	}
+
+	h := currentHooks()
+
+	reserved := GetReservedRanges()
+	for attempt := 0; attempt < DefaultMaxRerolls; attempt++ {
+
+		var randomBytes [16]byte
+		if _, err := io.ReadFull(this.Reader, randomBytes[:]); err != nil {
+			h.entropyError(err)
+			this.track(err)
+			return Empty, errors.Wrap(err, "read entropy")
+		}
+		this.track(nil)
+
+		randomBytes[6] &= 0x0f /* clear version        */
+		randomBytes[6] |= 0x40 /* set to version 4     */
+		randomBytes[8] &= 0x3f /* clear variant        */
+		randomBytes[8] |= 0x80 /* set to IETF variant  */
+
+		if err = uuid.UnmarshalBinary(randomBytes[:]); err != nil {
+			return uuid, err
+		}
+		if !reserved.Contains(uuid) {
+			if err := h.generated(uuid); err != nil {
+				return Empty, err
+			}
+			return uuid, nil
+		}
+	}
+	return Empty, errors.Wrap(ErrorRejected, "reserved range")
+}
+
+/**
+	Generator of Time-based UUIDs, versions 1 and 7
+
+    Guarantees strictly increasing timestamps for the UUIDs minted by the same generator,
+    if the clock did not move forward since the last call the previous timestamp is incremented.
+    Backward moves of the clock are handled by ClockRegressionPolicy, exhausted clock ticks by OverflowPolicy.
+ */
+
+type TimeGenerator struct {
+	sync.Mutex
+
+	version       Version
+	node          int64
+	clockSequence int
+
+	/**
+		Last used timestamp, in 100 nanos since UUID epoch for v1 and in unix millis for v7
+	 */
+
+	lastTime int64
+
+	/**
+		Last clock reading in the units of lastTime, that can be behind lastTime after the borrowing
+	 */
+
+	lastClock int64
+
+	/**
+		Last used 12-bit counter in rand_a field for v7
+	 */
+
+	counter uint64
+
+	/**
+		Precision of v7 timestamps, lastTime keeps unix millis followed by the fraction bits if not millis
+	 */
+
+	precision TimestampPrecision
+
+	regressionPolicy ClockRegressionPolicy
+	overflowPolicy   OverflowPolicy
+	maxClockWait     time.Duration
+
+	/**
+		Clock sequence set at start, used to detect wrapping of increments
+	 */
+
+	initialClockSequence int
+
+	/**
+		Counter of the clock sequences of version 1 UUIDs minted by NextAt
+	 */
+
+	backfillCounter int64
+
+	/**
+		Coordination slot kept referenced while the generator is alive
+	 */
+
+	lease *Lease
+
+	/**
+		Consecutive failures of Next and the last error, clock sample of the health check
+	 */
+
+	failures      int
+	lastError     error
+	healthClock   int64
+	healthSampled time.Time
+
+	/**
+		State file rewritten every stateSaveInterval of timestamps, last saved timestamp and write error
+	 */
+
+	stateFile  string
+	stateSaved int64
+	stateErr   error
+
+	now    func() time.Time
+	reader io.Reader
+}
+
+/**
+	Creates generator of Time-based UUIDs with random node and clock sequence
+
+    Random node has the multicast bit set as required by RFC 4122 section 4.5
+ */
+
+func NewTimeGenerator(version Version) (*TimeGenerator, error) {
+	return newTimeGenerator(version, rand.Reader)
+}
+
+/**
+	Creates generator seeded and fed from the reader
+ */
+
+func newTimeGenerator(version Version, reader io.Reader) (*TimeGenerator, error) {
+
+	if version != TimebasedVer1 && version != TimebasedVer7 {
+		return nil, errors.Errorf("unsupported time-based version: %v", version)
+	}
+
+	t := &TimeGenerator{
+		version:      version,
+		maxClockWait: DefaultMaxClockWait,
+		now:          time.Now,
+		reader:       reader,
+	}
+
+	var seed [8]byte
+	if _, err := io.ReadFull(t.reader, seed[:]); err != nil {
+		return nil, errors.Wrap(err, "read entropy")
+	}
+
+	bits := binary.BigEndian.Uint64(seed[:])
+	t.node = int64(bits&uint64(nodeMask)) | 0x010000000000
+	t.clockSequence = int(bits>>48) & clockSequenceBits
+	t.initialClockSequence = t.clockSequence
+
+	return t, nil
+}
+
+/**
+	Gets version of generated UUIDs
+ */
+
+func (this *TimeGenerator) Version() Version {
+	return this.version
+}
+
+/**
+	Gets 48-bit node used for version 1
+ */
+
+func (this *TimeGenerator) Node() int64 {
+	this.Lock()
+	defer this.Unlock()
+	return this.node
+}
+
+/**
+	Sets 48-bit node used for version 1
+ */
+
+func (this *TimeGenerator) SetNode(node int64) {
+	this.Lock()
+	defer this.Unlock()
+	this.node = node & nodeMask
+}
+
+/**
+	Gets 14-bit clock sequence used for version 1
+ */
+
+func (this *TimeGenerator) ClockSequence() int {
+	this.Lock()
+	defer this.Unlock()
+	return this.clockSequence
+}
+
+/**
+	Sets 14-bit clock sequence used for version 1
+ */
+
+func (this *TimeGenerator) SetClockSequence(clockSequence int) {
+	this.Lock()
+	defer this.Unlock()
+	this.clockSequence = clockSequence & clockSequenceBits
+	this.initialClockSequence = this.clockSequence
+}
+
+/**
+	Sets source of the wall clock, time.Now by default, used in tests and simulations
+ */
+
+func (this *TimeGenerator) SetClock(now func() time.Time) {
+	this.Lock()
+	defer this.Unlock()
+	this.now = now
+}
+
+/**
+	Generates next Time-based UUID, UUIDs in the reserved ranges are skipped
+
+    Next implements the Generator interface.
+ */
+
+func (this *TimeGenerator) Next() (UUID, error) {
+
+	if err := checkFrozen(); err != nil {
+		return Empty, err
+	}
+
+	this.Lock()
+	defer this.Unlock()
+
+	var uuid UUID
+	var err error
+	reserved := GetReservedRanges()
+	for attempt := 0; attempt < DefaultMaxRerolls; attempt++ {
+		switch {
+		case this.version == TimebasedVer1:
+			uuid, err = this.nextV1()
+		case this.precision != PrecisionMillis:
+			uuid, err = this.nextV7Precise()
+		default:
+			uuid, err = this.nextV7()
+		}
+		if err != nil || !reserved.Contains(uuid) {
+			break
+		}
+		uuid, err = Empty, errors.Wrap(ErrorRejected, "reserved range")
+	}
+
+	this.track(err)
+	if err == nil {
+		this.persist()
+	}
+	return uuid, err
+}
+
+/**
+	Counts consecutive failures for the health check
+ */
+
+func (this *TimeGenerator) track(err error) {
+	if err != nil {
+		this.failures++
+		this.lastError = err
+	} else {
+		this.failures = 0
+	}
+}
+
+func (this *TimeGenerator) nextV1() (uuid UUID, err error) {
+
+	h := currentHooks()
+
+	time100Nanos, err := this.tick(h)
+	if err != nil {
+		return Empty, err
+	}
+
+	if time100Nanos <= this.lastTime {
+		h.counterOverflow(this.version)
+		switch this.overflowPolicy {
+		case OverflowSpin:
+			if time100Nanos, err = this.spin(); err != nil {
+				return Empty, err
+			}
+		case OverflowFail:
+			return Empty, ErrorCounterOverflow
+		default:
+			time100Nanos = this.lastTime + 1
+		}
+	}
+	this.lastTime = time100Nanos
+
+	uuid.SetTime100Nanos(time100Nanos)
+	uuid.LeastSigBits = variantIETFBits
+	uuid.SetClockSequence(this.clockSequence)
+	uuid.SetNode(this.node)
+	if err := h.generated(uuid); err != nil {
+		return Empty, err
+	}
+	return uuid, nil
+}
+
+func (this *TimeGenerator) nextV7() (uuid UUID, err error) {
+
+	h := currentHooks()
+
+	var randomBytes [10]byte
+	if _, err := io.ReadFull(this.reader, randomBytes[:]); err != nil {
+		h.entropyError(err)
+		return Empty, errors.Wrap(err, "read entropy")
+	}
+
+	millis, err := this.tick(h)
+	if err != nil {
+		return Empty, err
+	}
+
+	if millis <= this.lastTime {
+		millis = this.lastTime
+		this.counter++
+		if this.counter > v7CounterMask {
+			h.counterOverflow(this.version)
+			switch this.overflowPolicy {
+			case OverflowSpin:
+				if millis, err = this.spin(); err != nil {
+					this.counter = v7CounterMask
+					return Empty, err
+				}
+				this.counter = uint64(binary.BigEndian.Uint16(randomBytes[8:])) & (v7CounterMask >> 1)
+			case OverflowFail:
+				this.counter = v7CounterMask
+				return Empty, ErrorCounterOverflow
+			default:
+				millis++
+				this.counter = 0
+			}
+		}
+	} else {
+		// start from the lower half to leave room for the increments within the same millisecond
+		this.counter = uint64(binary.BigEndian.Uint16(randomBytes[8:])) & (v7CounterMask >> 1)
+	}
+	this.lastTime = millis
+
+	uuid.MostSigBits = (uint64(millis) << 16) | v7VersionBits | this.counter
+	uuid.LeastSigBits = (binary.BigEndian.Uint64(randomBytes[:]) & counterMask &^ backfillBit) | variantIETFBits
+	if err := h.generated(uuid); err != nil {
+		return Empty, err
+	}
+	return uuid, nil
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerator(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	_, err := uuid.NewGenerator(uuid.NamebasedVer3)
+	assert.Error(t, err)
+
+	for _, version := range []uuid.Version{uuid.TimebasedVer1, uuid.RandomlyGeneratedVer4, uuid.TimebasedVer7} {
+
+		gen, err := uuid.NewGenerator(version)
+		assert.NoError(t, err)
+
+		seen := make(map[uuid.UUID]bool)
+		for i := 0; i < 1000; i++ {
+			id, err := gen.Next()
+			assert.NoError(t, err)
+			assert.Equal(t, version, id.Version())
+			assert.Equal(t, uuid.IETF, id.Variant())
+			assert.False(t, seen[id], "duplicate")
+			seen[id] = true
+		}
+	}
+
+	gen, err := uuid.NewTimeGenerator(uuid.TimebasedVer1)
+	assert.NoError(t, err)
+	gen.SetNode(0x0000AABBCCDDEEFF)
+	gen.SetClockSequence(0x1234)
+
+	prev, _ := gen.Next()
+	prevBin, _ := prev.MarshalSortableBinary()
+	assert.Equal(t, int64(0x0000AABBCCDDEEFF), prev.Node())
+	assert.Equal(t, 0x1234, prev.ClockSequence())
+
+	for i := 0; i < 1000; i++ {
+		id, _ := gen.Next()
+		bin, _ := id.MarshalSortableBinary()
+		assert.True(t, bytes.Compare(prevBin, bin) < 0)
+		prevBin = bin
+	}
+
+	_, err = uuid.NewTimeGenerator(uuid.RandomlyGeneratedVer4)
+	assert.Error(t, err)
+
+}
@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"io"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Writes UUID as quoted GraphQL string
+
+    MarshalGQL implements the graphql.Marshaler interface of gqlgen.
+ */
+
+func (this UUID) MarshalGQL(w io.Writer) {
+	data, _ := this.MarshalJSON()
+	w.Write(data)
+}
+
+/**
+	Reads UUID from GraphQL input value, only strings are accepted
+
+    UnmarshalGQL implements the graphql.Unmarshaler interface of gqlgen.
+ */
+
+func (this *UUID) UnmarshalGQL(v interface{}) error {
+
+	var s string
+	switch value := v.(type) {
+	case string:
+		s = value
+	case []byte:
+		s = string(value)
+	case nil:
+		return errors.New("UUID scalar must be a string, got null")
+	default:
+		return errors.Errorf("UUID scalar must be a string, got %T", v)
+	}
+
+	id, err := Parse(s)
+	if err != nil {
+		return errors.Wrap(err, "invalid UUID scalar")
+	}
+
+	*this = id
+	return nil
+}
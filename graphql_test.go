@@ -0,0 +1,39 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraphQL(t *testing.T) {
+
+	id, err := uuid.Parse("534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+	assert.NoError(t, err)
+
+	var buf bytes.Buffer
+	id.MarshalGQL(&buf)
+	assert.Equal(t, `"534b44a1-9bf1-3d20-b71e-cc4eb77c572f"`, buf.String())
+
+	var actual uuid.UUID
+	assert.NoError(t, actual.UnmarshalGQL("534b44a1-9bf1-3d20-b71e-cc4eb77c572f"))
+	assert.True(t, id.Equal(actual))
+
+	err = actual.UnmarshalGQL(123)
+	assert.EqualError(t, err, "UUID scalar must be a string, got int")
+
+	err = actual.UnmarshalGQL(nil)
+	assert.EqualError(t, err, "UUID scalar must be a string, got null")
+
+	err = actual.UnmarshalGQL("abc")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid UUID scalar")
+
+}
@@ -0,0 +1,171 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Maximum size of the group, the number of the 12-bit counter values within one millisecond of version 7
+ */
+
+const MaxGroupSize = int(v7CounterMask) + 1
+
+var ErrorGroupUnsupported = errors.New("generator does not support groups")
+
+/**
+	Generator minting groups of UUIDs sharing the timestamp
+ */
+
+type GroupGenerator interface {
+	Generator
+
+	/**
+		Generates n UUIDs with the same timestamp and consecutive counters
+	 */
+
+	NextGroup(n int) ([]UUID, error)
+}
+
+/**
+	Generates group of n UUIDs if the generator implements GroupGenerator
+ */
+
+func NextGroup(g Generator, n int) ([]UUID, error) {
+	if gg, ok := g.(GroupGenerator); ok {
+		return gg.NextGroup(n)
+	}
+	return nil, ErrorGroupUnsupported
+}
+
+/**
+	Generates n version 7 UUIDs with the same millisecond timestamp and consecutive counters in rand_a
+
+    A batch of related records can be recognized and range-scanned as a unit by the 64-bit key prefix.
+    If the rest of the counter within the current millisecond is too short, the group starts
+    in the next one according to OverflowPolicy. Supported only for version 7 with PrecisionMillis.
+    The whole group is re-rolled if any of its UUIDs is in the reserved ranges.
+
+    NextGroup implements the GroupGenerator interface.
+ */
+
+func (this *TimeGenerator) NextGroup(n int) ([]UUID, error) {
+
+	if err := checkFrozen(); err != nil {
+		return nil, err
+	}
+
+	if this.version != TimebasedVer7 {
+		return nil, errors.Wrapf(ErrorGroupUnsupported, "version %v", this.version)
+	}
+	if n < 1 || n > MaxGroupSize {
+		return nil, errors.Errorf("group size %d is out of range [1, %d]", n, MaxGroupSize)
+	}
+
+	this.Lock()
+	defer this.Unlock()
+
+	if this.precision != PrecisionMillis {
+		return nil, errors.Wrapf(ErrorGroupUnsupported, "precision %v", this.precision)
+	}
+
+	var ids []UUID
+	var err error
+	reserved := GetReservedRanges()
+	for attempt := 0; attempt < DefaultMaxRerolls; attempt++ {
+		if ids, err = this.nextGroup(n); err != nil || !containsAny(reserved, ids) {
+			break
+		}
+		ids, err = nil, errors.Wrap(ErrorRejected, "reserved range")
+	}
+
+	this.track(err)
+	if err == nil {
+		this.persist()
+	}
+	return ids, err
+}
+
+func containsAny(reserved ReservedRanges, ids []UUID) bool {
+	if len(reserved) == 0 {
+		return false
+	}
+	for _, id := range ids {
+		if reserved.Contains(id) {
+			return true
+		}
+	}
+	return false
+}
+
+func (this *TimeGenerator) nextGroup(n int) ([]UUID, error) {
+
+	h := currentHooks()
+
+	randomBytes := make([]byte, 2+8*n)
+	if _, err := io.ReadFull(this.reader, randomBytes); err != nil {
+		h.entropyError(err)
+		return nil, errors.Wrap(err, "read entropy")
+	}
+
+	millis, err := this.tick(h)
+	if err != nil {
+		return nil, err
+	}
+
+	last := uint64(n - 1)
+	start := groupStart(binary.BigEndian.Uint16(randomBytes), last)
+
+	if millis <= this.lastTime {
+		millis = this.lastTime
+		if this.counter+1+last <= v7CounterMask {
+			start = this.counter + 1
+		} else {
+			h.counterOverflow(this.version)
+			switch this.overflowPolicy {
+			case OverflowSpin:
+				if millis, err = this.spin(); err != nil {
+					return nil, err
+				}
+			case OverflowFail:
+				return nil, ErrorCounterOverflow
+			default:
+				millis++
+				start = 0
+			}
+		}
+	}
+
+	this.lastTime = millis
+	this.counter = start + last
+
+	ids := make([]UUID, n)
+	for i := range ids {
+		rand := binary.BigEndian.Uint64(randomBytes[2+8*i:])
+		ids[i].MostSigBits = (uint64(millis) << 16) | v7VersionBits | (start + uint64(i))
+		ids[i].LeastSigBits = (rand & counterMask &^ backfillBit) | variantIETFBits
+		if err := h.generated(ids[i]); err != nil {
+			return nil, err
+		}
+	}
+	return ids, nil
+}
+
+/**
+	Gets random start of the counter in the lower half leaving room for the group
+ */
+
+func groupStart(random uint16, last uint64) uint64 {
+	limit := v7CounterMask - last
+	if limit > v7CounterMask>>1 {
+		limit = v7CounterMask >> 1
+	}
+	return uint64(random) % (limit + 1)
+}
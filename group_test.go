@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextGroup(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	gen, err := uuid.NewTimeGenerator(uuid.TimebasedVer7)
+	assert.NoError(t, err)
+	now := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	gen.SetClock(func() time.Time { return now })
+
+	group, err := uuid.NextGroup(gen, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, 100, len(group))
+	for i, id := range group {
+		assert.Equal(t, uuid.TimebasedVer7, id.Version())
+		assert.Equal(t, now.UnixMilli(), id.UnixTimeMillis())
+		if i > 0 {
+			assert.Equal(t, group[i-1].MostSigBits+1, id.MostSigBits)
+			assert.True(t, uuid.ComparePostgres(group[i-1], id) < 0)
+		}
+	}
+
+	// next UUID and group continue within the same millisecond
+	next, err := gen.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, group[99].MostSigBits+1, next.MostSigBits)
+
+	group, err = gen.NextGroup(uuid.MaxGroupSize)
+	assert.NoError(t, err)
+	assert.Equal(t, now.UnixMilli()+1, group[0].UnixTimeMillis())
+	assert.Equal(t, uint64(0), group[0].MostSigBits&0xFFF)
+	assert.Equal(t, uint64(0xFFF), group[len(group)-1].MostSigBits&0xFFF)
+	assert.True(t, uuid.ComparePostgres(next, group[0]) < 0)
+
+	gen.SetOverflowPolicy(uuid.OverflowFail)
+	_, err = gen.NextGroup(1)
+	assert.Equal(t, uuid.ErrorCounterOverflow, err)
+
+	now = now.Add(time.Second)
+	_, err = gen.NextGroup(1)
+	assert.NoError(t, err)
+
+	_, err = gen.NextGroup(0)
+	assert.Error(t, err)
+	_, err = gen.NextGroup(uuid.MaxGroupSize + 1)
+	assert.Error(t, err)
+
+	v1, err := uuid.NewTimeGenerator(uuid.TimebasedVer1)
+	assert.NoError(t, err)
+	_, err = v1.NextGroup(2)
+	assert.ErrorIs(t, err, uuid.ErrorGroupUnsupported)
+
+	_, err = uuid.NextGroup(uuid.NewRandomGenerator(), 2)
+	assert.Equal(t, uuid.ErrorGroupUnsupported, err)
+}
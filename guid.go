@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Gets C initializer of GUID struct used in Windows headers and .NET Guid.ToString("X"),
+    e.g. {0x534b44a1,0x9bf1,0x3d20,{0xb7,0x1e,0xcc,0x4e,0xb7,0x7c,0x57,0x2f}}
+ */
+
+func (this UUID) GUIDInitializer() string {
+
+	var sb strings.Builder
+	sb.Grow(68)
+
+	sb.WriteString("{0x")
+	writeHex(&sb, this.MostSigBits>>32, 8)
+	sb.WriteString(",0x")
+	writeHex(&sb, this.MostSigBits>>16, 4)
+	sb.WriteString(",0x")
+	writeHex(&sb, this.MostSigBits, 4)
+	sb.WriteString(",{")
+	for i := 0; i < 8; i++ {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString("0x")
+		writeHex(&sb, this.LeastSigBits>>(56-8*i), 2)
+	}
+	sb.WriteString("}}")
+	return sb.String()
+}
+
+/**
+	Parses C initializer of GUID struct scraped from C/C++ sources
+
+    Whitespace is ignored, hex digits are case insensitive and may be shorter than the field,
+    e.g. { 0x534B44A1, 0x9BF1, 0x3D20, { 0xB7, 0x1E, 0xCC, 0x4E, 0xB7, 0x7C, 0x57, 0x2F } }
+ */
+
+func ParseGUIDInitializer(s string) (uuid UUID, err error) {
+
+	compact := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\t', '\r', '\n':
+			return -1
+		}
+		return r
+	}, s)
+
+	p := guidParser{s: compact}
+
+	p.expect('{')
+	data1 := p.field(8)
+	p.expect(',')
+	data2 := p.field(4)
+	p.expect(',')
+	data3 := p.field(4)
+	p.expect(',')
+	p.expect('{')
+	var data4 uint64
+	for i := 0; i < 8; i++ {
+		if i > 0 {
+			p.expect(',')
+		}
+		data4 = data4<<8 | p.field(2)
+	}
+	p.expect('}')
+	p.expect('}')
+
+	if p.err == nil && p.pos != len(p.s) {
+		p.fail("trailing characters")
+	}
+	if p.err != nil {
+		return Empty, errors.Errorf("invalid GUID initializer %q: %v", s, p.err)
+	}
+
+	uuid.MostSigBits = data1<<32 | data2<<16 | data3
+	uuid.LeastSigBits = data4
+	return uuid, nil
+}
+
+type guidParser struct {
+	s   string
+	pos int
+	err error
+}
+
+func (this *guidParser) fail(msg string) {
+	if this.err == nil {
+		this.err = errors.Errorf("%s at offset %d", msg, this.pos)
+	}
+}
+
+func (this *guidParser) expect(c byte) {
+	if this.err != nil {
+		return
+	}
+	if this.pos >= len(this.s) || this.s[this.pos] != c {
+		this.fail("expected '" + string(c) + "'")
+		return
+	}
+	this.pos++
+}
+
+func (this *guidParser) field(digits int) uint64 {
+	if this.err != nil {
+		return 0
+	}
+	if !strings.HasPrefix(this.s[this.pos:], "0x") && !strings.HasPrefix(this.s[this.pos:], "0X") {
+		this.fail("expected 0x")
+		return 0
+	}
+	this.pos += 2
+	start := this.pos
+	for this.pos < len(this.s) && hexIndex[this.s[this.pos]] >= 0 {
+		this.pos++
+	}
+	if this.pos == start || this.pos-start > digits {
+		this.fail("expected up to " + strconv.Itoa(digits) + " hex digits")
+		return 0
+	}
+	value, _ := strconv.ParseUint(this.s[start:this.pos], 16, 64)
+	return value
+}
+
+func writeHex(sb *strings.Builder, value uint64, digits int) {
+	for i := digits - 1; i >= 0; i-- {
+		sb.WriteByte(hexDigits[(value>>(4*i))&0xF])
+	}
+}
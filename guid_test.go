@@ -0,0 +1,50 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGUIDInitializer(t *testing.T) {
+
+	id, err := uuid.Parse("534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+	assert.NoError(t, err)
+
+	s := id.GUIDInitializer()
+	assert.Equal(t, "{0x534b44a1,0x9bf1,0x3d20,{0xb7,0x1e,0xcc,0x4e,0xb7,0x7c,0x57,0x2f}}", s)
+
+	parsed, err := uuid.ParseGUIDInitializer(s)
+	assert.NoError(t, err)
+	assert.Equal(t, id, parsed)
+
+	parsed, err = uuid.ParseGUIDInitializer("{ 0x534B44A1, 0x9BF1, 0x3D20,\n\t{ 0xB7, 0x1E, 0xCC, 0x4E, 0xB7, 0x7C, 0x57, 0x2F } }")
+	assert.NoError(t, err)
+	assert.Equal(t, id, parsed)
+
+	parsed, err = uuid.ParseGUIDInitializer("{0x1,0x0,0x0,{0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xa}}")
+	assert.NoError(t, err)
+	assert.Equal(t, "00000001-0000-0000-0000-00000000000a", parsed.String())
+
+	canonical, err := uuid.Canonicalize(" { 0x534B44A1, 0x9BF1, 0x3D20, { 0xB7, 0x1E, 0xCC, 0x4E, 0xB7, 0x7C, 0x57, 0x2F } } ")
+	assert.NoError(t, err)
+	assert.Equal(t, id.String(), canonical)
+
+	for _, bad := range []string{
+		"",
+		"{0x534b44a1,0x9bf1,0x3d20,{0xb7,0x1e,0xcc,0x4e,0xb7,0x7c,0x57}}",
+		"{0x534b44a1,0x9bf1,0x3d20,{0xb7,0x1e,0xcc,0x4e,0xb7,0x7c,0x57,0x2f}}x",
+		"{0x534b44a1f,0x9bf1,0x3d20,{0xb7,0x1e,0xcc,0x4e,0xb7,0x7c,0x57,0x2f}}",
+		"{534b44a1,0x9bf1,0x3d20,{0xb7,0x1e,0xcc,0x4e,0xb7,0x7c,0x57,0x2f}}",
+		"{0x,0x9bf1,0x3d20,{0xb7,0x1e,0xcc,0x4e,0xb7,0x7c,0x57,0x2f}}",
+	} {
+		_, err := uuid.ParseGUIDInitializer(bad)
+		assert.Error(t, err, bad)
+	}
+}
@@ -0,0 +1,162 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	crand "crypto/rand"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Thresholds of the health checks
+ */
+
+const (
+	healthMaxFailures = 3
+	healthMaxLead     = time.Second
+	healthStuckAfter  = time.Second
+)
+
+/**
+	Generator reporting its health, suitable for readiness probes
+ */
+
+type HealthChecker interface {
+
+	/**
+		Gets nil if the generator is able to mint UUIDs
+	 */
+
+	Healthy() error
+}
+
+/**
+	Checks consecutive entropy failures of Next, probes crypto/rand if it is the source
+
+    Other sources are not read by the check, so seeded sequences are not shifted.
+    Healthy implements the HealthChecker interface.
+ */
+
+func (this *RandomGenerator) Healthy() error {
+
+	this.healthLock.Lock()
+	failures, lastError := this.failures, this.lastError
+	this.healthLock.Unlock()
+
+	if failures >= healthMaxFailures {
+		return errors.Wrapf(lastError, "%d consecutive entropy failures", failures)
+	}
+
+	if this.Reader == crand.Reader {
+		var probe [16]byte
+		if _, err := io.ReadFull(crand.Reader, probe[:]); err != nil {
+			return errors.Wrap(err, "entropy source failed")
+		}
+	}
+	return nil
+}
+
+/**
+	Counts consecutive entropy failures for the health check
+ */
+
+func (this *RandomGenerator) track(err error) {
+	this.healthLock.Lock()
+	defer this.healthLock.Unlock()
+	if err != nil {
+		this.failures++
+		this.lastError = err
+	} else {
+		this.failures = 0
+	}
+}
+
+/**
+	Checks consecutive failures, state file writes, clock regression, stuck clock
+	and timestamps borrowed far ahead of the clock
+
+    Stuck clock is detected by two health checks at least a second apart.
+    Healthy implements the HealthChecker interface.
+ */
+
+func (this *TimeGenerator) Healthy() error {
+
+	this.Lock()
+	defer this.Unlock()
+
+	if this.failures >= healthMaxFailures {
+		return errors.Wrapf(this.lastError, "%d consecutive failures", this.failures)
+	}
+
+	if this.stateErr != nil {
+		return errors.Wrap(this.stateErr, "state file is not saved")
+	}
+
+	clock := this.readClock()
+
+	if clock < this.lastClock {
+		return errors.Errorf("clock is behind the last reading by %v", this.clockDuration(this.lastClock-clock))
+	}
+
+	if this.lastTime > clock {
+		if lead := this.clockDuration(this.lastTime - clock); lead > healthMaxLead {
+			return errors.Errorf("counter saturated, timestamps are %v ahead of the clock", lead)
+		}
+	}
+
+	now := time.Now()
+	if !this.healthSampled.IsZero() && now.Sub(this.healthSampled) >= healthStuckAfter && clock == this.healthClock {
+		return errors.Errorf("clock is stuck for %v", now.Sub(this.healthSampled))
+	}
+	if this.healthSampled.IsZero() || clock != this.healthClock {
+		this.healthClock, this.healthSampled = clock, now
+	}
+
+	return nil
+}
+
+/**
+	Checks that the logical clock is not too far ahead of the physical clock
+
+    Healthy implements the HealthChecker interface.
+ */
+
+func (this *HLCGenerator) Healthy() error {
+	this.Lock()
+	defer this.Unlock()
+	lead := this.wall - this.physical()
+	if this.maxOffset > 0 && lead > int64(this.maxOffset/time.Millisecond) {
+		return errors.Errorf("HLC is %dms ahead of the physical clock", lead)
+	}
+	return nil
+}
+
+/**
+	Checks all registered generators implementing HealthChecker
+ */
+
+func (this *Registry) Healthy() error {
+	var failed []string
+	for _, name := range this.Names() {
+		gen, ok := this.Get(name)
+		if !ok {
+			continue
+		}
+		if checker, ok := gen.(HealthChecker); ok {
+			if err := checker.Healthy(); err != nil {
+				failed = append(failed, name+": "+err.Error())
+			}
+		}
+	}
+	if len(failed) > 0 {
+		return errors.Errorf("unhealthy generators: %s", strings.Join(failed, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealthy(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	var checker uuid.HealthChecker = uuid.NewRandomGenerator()
+	assert.NoError(t, checker.Healthy())
+
+	broken := &uuid.RandomGenerator{Reader: failingReader{}}
+	assert.NoError(t, broken.Healthy())
+	for i := 0; i < 3; i++ {
+		broken.Next()
+	}
+	assert.Error(t, broken.Healthy())
+
+	// the check does not shift the seeded sequence
+	seeded := &uuid.RandomGenerator{Reader: rand.New(rand.NewSource(1))}
+	probed := &uuid.RandomGenerator{Reader: rand.New(rand.NewSource(1))}
+	assert.NoError(t, probed.Healthy())
+	a, err := seeded.Next()
+	assert.NoError(t, err)
+	b, err := probed.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, a, b)
+
+	now := time.Now()
+	gen, err := uuid.NewTimeGenerator(uuid.TimebasedVer1)
+	assert.NoError(t, err)
+	gen.SetClock(func() time.Time { return now })
+	assert.NoError(t, gen.Healthy())
+
+	gen.SetOverflowPolicy(uuid.OverflowFail)
+	for i := 0; i < 4; i++ {
+		gen.Next()
+	}
+	assert.Error(t, gen.Healthy())
+
+	now = now.Add(time.Millisecond)
+	_, err = gen.Next()
+	assert.NoError(t, err)
+	assert.NoError(t, gen.Healthy())
+
+	now = now.Add(-time.Minute)
+	assert.Error(t, gen.Healthy())
+	now = now.Add(time.Minute)
+
+	ahead, err := uuid.NewTimeGenerator(uuid.TimebasedVer1)
+	assert.NoError(t, err)
+	ahead.SetClock(func() time.Time { return now.Add(time.Minute) })
+	ahead.Next()
+	state, err := ahead.SaveState()
+	assert.NoError(t, err)
+	assert.NoError(t, gen.RestoreState(state))
+
+	err = gen.Healthy()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "saturated")
+	}
+
+	registry := uuid.NewRegistry()
+	registry.Register("broken", gen)
+	registry.Register("random", uuid.NewRandomGenerator())
+	err = registry.Healthy()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "broken:")
+		assert.NotContains(t, err.Error(), "random:")
+	}
+
+	hlc := uuid.NewHLCGenerator()
+	assert.NoError(t, hlc.Healthy())
+
+	wall := time.Now().UnixNano()/int64(time.Millisecond) + 1<<44
+	hlc.SetMaxOffset(0)
+	assert.NoError(t, hlc.Observe(uuid.UUID{MostSigBits: uint64(wall)<<16 | 0x7000, LeastSigBits: 0x8000000000000000}))
+	hlc.SetMaxOffset(uuid.DefaultHLCMaxOffset)
+	assert.Error(t, hlc.Healthy())
+
+}
+
+func TestHealthyStateFile(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	dir, err := os.MkdirTemp("", "uuid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	stateDir := filepath.Join(dir, "state")
+	assert.NoError(t, os.Mkdir(stateDir, 0755))
+	state := filepath.Join(stateDir, "uuid.state")
+
+	g, err := uuid.NewGeneratorFromConfig(uuid.GeneratorConfig{Version: 7, StateFile: state})
+	assert.NoError(t, err)
+	gen := g.(*uuid.TimeGenerator)
+
+	now := time.Now()
+	gen.SetClock(func() time.Time { return now })
+	_, err = gen.Next()
+	assert.NoError(t, err)
+	assert.NoError(t, gen.Healthy())
+
+	// rewritten after 10 seconds
+	now = now.Add(11 * time.Second)
+	last, err := gen.Next()
+	assert.NoError(t, err)
+	restored, err := uuid.NewTimeGenerator(uuid.TimebasedVer7)
+	assert.NoError(t, err)
+	data, err := os.ReadFile(state)
+	assert.NoError(t, err)
+	assert.NoError(t, restored.RestoreState(data))
+	restored.SetClock(func() time.Time { return now.Add(-time.Minute) })
+	next, err := restored.Next()
+	assert.NoError(t, err)
+	assert.True(t, uuid.ComparePostgres(last, next) < 0)
+
+	assert.NoError(t, os.RemoveAll(stateDir))
+	now = now.Add(11 * time.Second)
+	_, err = gen.Next()
+	assert.NoError(t, err)
+	err = gen.Healthy()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "state file")
+	}
+
+	assert.NoError(t, os.Mkdir(stateDir, 0755))
+	now = now.Add(11 * time.Second)
+	_, err = gen.Next()
+	assert.NoError(t, err)
+	assert.NoError(t, gen.Healthy())
+}
@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"sort"
+	"time"
+)
+
+/**
+	Number of UUIDs with timestamps in the bucket starting at the time
+ */
+
+type HistogramBucket struct {
+	Start time.Time
+	Count int
+}
+
+/**
+	Interval without UUIDs between two non-empty buckets
+ */
+
+type HistogramGap struct {
+	From time.Time
+	To   time.Time
+}
+
+/**
+	Bucketed histogram of timestamps of Time-based UUIDs, versions 1, 6 and 7
+
+    Useful to audit data retention and spot backfilled records purely from keys,
+    memory is proportional to the number of non-empty buckets
+ */
+
+type TimeHistogram struct {
+	bucket  int64
+	counts  map[int64]int
+	total   int
+	skipped int
+
+	earliest int64
+	latest   int64
+}
+
+/**
+	Creates histogram with the bucket size, e.g. time.Minute, time.Hour or 24 * time.Hour
+ */
+
+func NewTimeHistogram(bucket time.Duration) *TimeHistogram {
+	size := int64(bucket / 100)
+	if size <= 0 {
+		size = 1
+	}
+	return &TimeHistogram{bucket: size, counts: make(map[int64]int)}
+}
+
+/**
+	Adds UUID to the histogram, returns false and counts it as skipped if it has no timestamp
+ */
+
+func (this *TimeHistogram) Add(id UUID) bool {
+
+	t, ok := id.unixTime100Nanos()
+	if !ok {
+		this.skipped++
+		return false
+	}
+
+	if this.total == 0 || t < this.earliest {
+		this.earliest = t
+	}
+	if this.total == 0 || t > this.latest {
+		this.latest = t
+	}
+	this.total++
+
+	start := t / this.bucket
+	if t < 0 && t%this.bucket != 0 {
+		start--
+	}
+	this.counts[start]++
+	return true
+}
+
+/**
+	Gets number of added Time-based UUIDs
+ */
+
+func (this *TimeHistogram) Count() int {
+	return this.total
+}
+
+/**
+	Gets number of skipped UUIDs without timestamps
+ */
+
+func (this *TimeHistogram) Skipped() int {
+	return this.skipped
+}
+
+/**
+	Gets the earliest timestamp, zero time if empty
+ */
+
+func (this *TimeHistogram) Earliest() time.Time {
+	if this.total == 0 {
+		return time.Time{}
+	}
+	return unix100NanosToTime(this.earliest)
+}
+
+/**
+	Gets the latest timestamp, zero time if empty
+ */
+
+func (this *TimeHistogram) Latest() time.Time {
+	if this.total == 0 {
+		return time.Time{}
+	}
+	return unix100NanosToTime(this.latest)
+}
+
+/**
+	Gets non-empty buckets ordered by time
+ */
+
+func (this *TimeHistogram) Buckets() []HistogramBucket {
+	starts := this.starts()
+	buckets := make([]HistogramBucket, len(starts))
+	for i, start := range starts {
+		buckets[i] = HistogramBucket{Start: unix100NanosToTime(start * this.bucket), Count: this.counts[start]}
+	}
+	return buckets
+}
+
+/**
+	Gets intervals of empty buckets between non-empty ones, not shorter than the min gap
+ */
+
+func (this *TimeHistogram) Gaps(minGap time.Duration) []HistogramGap {
+	var gaps []HistogramGap
+	starts := this.starts()
+	for i := 1; i < len(starts); i++ {
+		from := (starts[i-1] + 1) * this.bucket
+		to := starts[i] * this.bucket
+		if to > from && time.Duration(to-from)*100 >= minGap {
+			gaps = append(gaps, HistogramGap{From: unix100NanosToTime(from), To: unix100NanosToTime(to)})
+		}
+	}
+	return gaps
+}
+
+func (this *TimeHistogram) starts() []int64 {
+	starts := make([]int64, 0, len(this.counts))
+	for start := range this.counts {
+		starts = append(starts, start)
+	}
+	sort.Slice(starts, func(i, j int) bool { return starts[i] < starts[j] })
+	return starts
+}
+
+func unix100NanosToTime(t int64) time.Time {
+	return time.Unix(t/one100NanosInSecond, (t%one100NanosInSecond)*100).UTC()
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeHistogram(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	base := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	gen, err := uuid.NewTimeGenerator(uuid.TimebasedVer7)
+	assert.NoError(t, err)
+	now := base
+	gen.SetClock(func() time.Time { return now })
+
+	h := uuid.NewTimeHistogram(time.Hour)
+
+	for _, offset := range []time.Duration{0, 10 * time.Minute, 59 * time.Minute, 3 * time.Hour, 3*time.Hour + time.Second} {
+		now = base.Add(offset)
+		id, err := gen.Next()
+		assert.NoError(t, err)
+		assert.True(t, h.Add(id))
+	}
+
+	v1 := uuid.New(uuid.TimebasedVer1)
+	v1.SetTime(base.Add(90 * time.Minute))
+	assert.True(t, h.Add(v1))
+	assert.False(t, h.Add(uuid.New(uuid.RandomlyGeneratedVer4)))
+
+	assert.Equal(t, 6, h.Count())
+	assert.Equal(t, 1, h.Skipped())
+	assert.Equal(t, base, h.Earliest())
+	assert.Equal(t, base.Add(3*time.Hour+time.Second), h.Latest())
+
+	assert.Equal(t, []uuid.HistogramBucket{
+		{Start: base, Count: 3},
+		{Start: base.Add(time.Hour), Count: 1},
+		{Start: base.Add(3 * time.Hour), Count: 2},
+	}, h.Buckets())
+
+	assert.Equal(t, []uuid.HistogramGap{{From: base.Add(2 * time.Hour), To: base.Add(3 * time.Hour)}}, h.Gaps(time.Hour))
+	assert.Empty(t, h.Gaps(2*time.Hour))
+
+	empty := uuid.NewTimeHistogram(time.Minute)
+	assert.True(t, empty.Earliest().IsZero())
+	assert.Empty(t, empty.Buckets())
+
+	// the largest 48-bit millis overflow nanoseconds of int64
+	far := uuid.NewTimeHistogram(time.Hour)
+	assert.True(t, far.Add(uuid.MustParse("ffffffff-ffff-7fff-bfff-ffffffffffff")))
+	assert.Equal(t, time.UnixMilli(1<<48-1).UTC(), far.Latest())
+	assert.Equal(t, 10889, far.Buckets()[0].Start.Year())
+
+}
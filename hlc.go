@@ -0,0 +1,205 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Layout of Hybrid Logical Clock version 8 UUID
+
+	msb: 48-bit HLC physical time in unix millis + 4-bit version + 12-bit HLC logical counter
+	lsb: 2-bit variant + 62-bit random
+
+    The layout matches version 7, so HLC and v7 UUIDs are ordered together by bytes
+ */
+
+const (
+	hlcLogicalMask = uint64(0x0000000000000FFF)
+
+	DefaultHLCMaxOffset = time.Minute
+)
+
+var ErrorHLCOffset = errors.New("remote HLC time is too far ahead of the local clock")
+
+/**
+	Generator of version 8 UUIDs ordered by Hybrid Logical Clock
+
+    Every UUID minted after Observe of the remote UUID is greater than the remote one,
+    so causally related events are ordered without perfectly synchronized clocks.
+ */
+
+type HLCGenerator struct {
+	sync.Mutex
+
+	/**
+		HLC physical time in unix millis and logical counter
+	 */
+
+	wall    int64
+	logical uint64
+
+	maxOffset time.Duration
+
+	now    func() time.Time
+	reader io.Reader
+}
+
+/**
+	Creates HLC generator backed by the wall clock and crypto/rand
+ */
+
+func NewHLCGenerator() *HLCGenerator {
+	return &HLCGenerator{
+		maxOffset: DefaultHLCMaxOffset,
+		now:       time.Now,
+		reader:    rand.Reader,
+	}
+}
+
+/**
+	Sets source of the wall clock, time.Now by default
+ */
+
+func (this *HLCGenerator) SetClock(now func() time.Time) {
+	this.Lock()
+	defer this.Unlock()
+	this.now = now
+}
+
+/**
+	Sets max distance of the observed remote time ahead of the local wall clock, DefaultHLCMaxOffset by default
+
+    Zero or negative value disables the check
+ */
+
+func (this *HLCGenerator) SetMaxOffset(d time.Duration) {
+	this.Lock()
+	defer this.Unlock()
+	this.maxOffset = d
+}
+
+func (this *HLCGenerator) physical() int64 {
+	return this.now().UnixNano() / int64(time.Millisecond)
+}
+
+/**
+	Increments logical counter, moves physical time forward when the counter is exhausted
+ */
+
+func (this *HLCGenerator) advance(logical uint64) {
+	if logical > hlcLogicalMask {
+		this.wall++
+		logical = 0
+	}
+	this.logical = logical
+}
+
+/**
+	Generates next HLC UUID, a send or local event of the clock
+
+    Next implements the Generator interface.
+ */
+
+func (this *HLCGenerator) Next() (uuid UUID, err error) {
+
+	if err := checkFrozen(); err != nil {
+		return Empty, err
+	}
+
+	this.Lock()
+	defer this.Unlock()
+
+	h := currentHooks()
+
+	if pt := this.physical(); pt > this.wall {
+		this.wall = pt
+		this.logical = 0
+	} else {
+		this.advance(this.logical + 1)
+	}
+
+	uuid, err = rerollReserved(func() (uuid UUID, err error) {
+		var randomBytes [8]byte
+		if _, err := io.ReadFull(this.reader, randomBytes[:]); err != nil {
+			h.entropyError(err)
+			return Empty, errors.Wrap(err, "read entropy")
+		}
+		uuid.MostSigBits = (uint64(this.wall) << 16) | v8VersionBits | this.logical
+		uuid.LeastSigBits = (binary.BigEndian.Uint64(randomBytes[:]) & counterMask) | variantIETFBits
+		return uuid, nil
+	})
+	if err != nil {
+		return Empty, err
+	}
+	if err := h.generated(uuid); err != nil {
+		return Empty, err
+	}
+	return uuid, nil
+}
+
+/**
+	Merges remote HLC or v7 UUID into the clock, a receive event
+
+    UUIDs generated after Observe are greater than the remote one
+ */
+
+func (this *HLCGenerator) Observe(remote UUID) error {
+
+	if v := remote.Version(); v != CustomVer8 && v != TimebasedVer7 {
+		return errors.Errorf("HLC requires version 7 or 8 UUID, got %v", v)
+	}
+	remoteWall, remoteLogical := remote.HLC()
+
+	this.Lock()
+	defer this.Unlock()
+
+	pt := this.physical()
+	if this.maxOffset > 0 && remoteWall-pt > int64(this.maxOffset/time.Millisecond) {
+		return ErrorHLCOffset
+	}
+
+	wall := this.wall
+	if remoteWall > wall {
+		wall = remoteWall
+	}
+	if pt > wall {
+		wall = pt
+	}
+
+	switch {
+	case wall == this.wall && wall == remoteWall:
+		logical := this.logical
+		if uint64(remoteLogical) > logical {
+			logical = uint64(remoteLogical)
+		}
+		this.advance(logical + 1)
+	case wall == this.wall:
+		this.advance(this.logical + 1)
+	case wall == remoteWall:
+		this.wall = wall
+		this.advance(uint64(remoteLogical) + 1)
+	default:
+		this.wall = wall
+		this.logical = 0
+	}
+	return nil
+}
+
+/**
+	Gets HLC physical time in unix millis and logical counter of the HLC version 8 UUID
+ */
+
+func (this UUID) HLC() (wallMillis int64, logical int) {
+	return int64(this.MostSigBits >> 16), int(this.MostSigBits & hlcLogicalMask)
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHLCGenerator(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	now := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	local := uuid.NewHLCGenerator()
+	local.SetClock(func() time.Time { return now })
+
+	remoteNow := now.Add(10 * time.Second)
+	remote := uuid.NewHLCGenerator()
+	remote.SetClock(func() time.Time { return remoteNow })
+
+	first, err := local.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.CustomVer8, first.Version())
+	assert.Equal(t, uuid.IETF, first.Variant())
+
+	wall, logical := first.HLC()
+	assert.Equal(t, now.UnixNano()/int64(time.Millisecond), wall)
+	assert.Equal(t, 0, logical)
+
+	second, _ := local.Next()
+	wall, logical = second.HLC()
+	assert.Equal(t, now.UnixNano()/int64(time.Millisecond), wall)
+	assert.Equal(t, 1, logical)
+
+	message, _ := remote.Next()
+	assert.NoError(t, local.Observe(message))
+
+	reply, err := local.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, uuid.ComparePostgres(reply, message))
+	wall, logical = reply.HLC()
+	assert.Equal(t, remoteNow.UnixNano()/int64(time.Millisecond), wall)
+	assert.Equal(t, 2, logical)
+
+	assert.NoError(t, remote.Observe(reply))
+	next, _ := remote.Next()
+	assert.Equal(t, 1, uuid.ComparePostgres(next, reply))
+
+	remoteNow = now.Add(time.Hour)
+	far, _ := remote.Next()
+	assert.Equal(t, uuid.ErrorHLCOffset, local.Observe(far))
+
+	local.SetMaxOffset(0)
+	assert.NoError(t, local.Observe(far))
+
+	assert.Error(t, local.Observe(uuid.New(uuid.RandomlyGeneratedVer4)))
+
+}
+
+func TestHLCFarFuture(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	now := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	local := uuid.NewHLCGenerator()
+	local.SetClock(func() time.Time { return now })
+
+	for _, ahead := range []int64{1 << 44, 1<<48 - 1 - now.UnixNano()/int64(time.Millisecond)} {
+		wall := now.UnixNano()/int64(time.Millisecond) + ahead
+		far := uuid.UUID{MostSigBits: uint64(wall)<<16 | 0x7000, LeastSigBits: 0x8000000000000000}
+		assert.Equal(t, uuid.ErrorHLCOffset, local.Observe(far))
+	}
+
+	id, err := local.Next()
+	assert.NoError(t, err)
+	wall, _ := id.HLC()
+	assert.Equal(t, now.UnixNano()/int64(time.Millisecond), wall)
+}
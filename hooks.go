@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+/**
+	Callbacks of the generator events, used by metrics and logging
+
+    Callbacks are invoked synchronously, sometimes under the generator lock,
+    so they must be fast and must not call generators. Nil callbacks are skipped.
+ */
+
+type Hooks struct {
+
+	/**
+		Called for every UUID minted by RandomUUID, the generators of this package, TimeLayout.New and NewExpiring,
+		except for the deterministic Sandbox and ReplayGenerator
+	 */
+
+	OnGenerate func(id UUID)
+
+	/**
+		Called for every UUID passed to OnGenerate after it, before the UUID
+		is handed out. When it fails, the UUID is dropped and the generator returns the error,
+		e.g. AsyncAuditor.Hook auditing all generators of the process
+	 */
+
+	OnMint func(id UUID) error
+
+	/**
+		Called when the wall clock is behind the last used timestamp of the time-based generator
+	 */
+
+	OnClockRegression func(version Version, backwards time.Duration)
+
+	/**
+		Called when the time-based generator exhausted its counter within one clock tick
+	 */
+
+	OnCounterOverflow func(version Version)
+
+	/**
+		Called when reading random bits failed
+	 */
+
+	OnEntropyError func(err error)
+}
+
+var hooks atomic.Value
+
+func init() {
+	hooks.Store(&Hooks{})
+}
+
+/**
+	Installs process-wide hooks, returns previously installed ones
+ */
+
+func SetHooks(h Hooks) Hooks {
+	return *hooks.Swap(&h).(*Hooks)
+}
+
+/**
+	Gets process-wide hooks
+ */
+
+func GetHooks() Hooks {
+	return *hooks.Load().(*Hooks)
+}
+
+func currentHooks() *Hooks {
+	return hooks.Load().(*Hooks)
+}
+
+func (h *Hooks) generated(id UUID) error {
+	if h.OnGenerate != nil {
+		h.OnGenerate(id)
+	}
+	if h.OnMint != nil {
+		return h.OnMint(id)
+	}
+	return nil
+}
+
+/**
+	Passes UUID minted outside of the generators through the hooks
+ */
+
+func minted(uuid UUID, err error) (UUID, error) {
+	if err != nil {
+		return Empty, err
+	}
+	if err := currentHooks().generated(uuid); err != nil {
+		return Empty, err
+	}
+	return uuid, nil
+}
+
+func (h *Hooks) clockRegression(version Version, backwards time.Duration) {
+	if h.OnClockRegression != nil {
+		h.OnClockRegression(version, backwards)
+	}
+}
+
+func (h *Hooks) counterOverflow(version Version) {
+	if h.OnCounterOverflow != nil {
+		h.OnCounterOverflow(version)
+	}
+}
+
+func (h *Hooks) entropyError(err error) {
+	if h.OnEntropyError != nil {
+		h.OnEntropyError(err)
+	}
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type failingReader struct{}
+
+func (failingReader) Read(p []byte) (int, error) {
+	return 0, errors.New("no entropy")
+}
+
+func TestHooks(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	var generated, overflows, entropyErrors int
+	var backwards time.Duration
+
+	prev := uuid.SetHooks(uuid.Hooks{
+		OnGenerate:        func(id uuid.UUID) { generated++ },
+		OnClockRegression: func(version uuid.Version, d time.Duration) { backwards = d },
+		OnCounterOverflow: func(version uuid.Version) { overflows++ },
+		OnEntropyError:    func(err error) { entropyErrors++ },
+	})
+	defer uuid.SetHooks(prev)
+
+	assert.NotNil(t, uuid.GetHooks().OnGenerate)
+
+	gen, err := uuid.NewTimeGenerator(uuid.TimebasedVer7)
+	assert.NoError(t, err)
+
+	now := time.Now()
+	gen.SetClock(func() time.Time { return now })
+
+	for i := 0; i < 5000; i++ {
+		_, err := gen.Next()
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 5000, generated)
+	assert.True(t, overflows > 0)
+
+	now = now.Add(-time.Second)
+	_, err = gen.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Second, backwards)
+
+	_, err = (&uuid.RandomGenerator{Reader: failingReader{}}).Next()
+	assert.Error(t, err)
+	assert.Equal(t, 1, entropyErrors)
+
+}
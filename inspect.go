@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+/**
+	Decoded view of the UUID fields suitable for logging and JSON output
+ */
+
+type Info struct {
+	ID            string    `json:"id"`
+	Name          string    `json:"name,omitempty"`
+	Version       string    `json:"version"`
+	Variant       string    `json:"variant"`
+	Timestamp     string    `json:"timestamp,omitempty"`
+	Node          string    `json:"node,omitempty"`
+	ClockSequence *int      `json:"clockSequence,omitempty"`
+	Encodings     Encodings `json:"encodings"`
+}
+
+/**
+	All string representations of the UUID
+ */
+
+type Encodings struct {
+	Canonical         string `json:"canonical"`
+	URN               string `json:"urn"`
+	Hex               string `json:"hex"`
+	Base64            string `json:"base64"`
+	Base64URL         string `json:"base64url"`
+	Base58            string `json:"base58"`
+	ULID              string `json:"ulid"`
+	SortableBinaryHex string `json:"sortableBinaryHex,omitempty"`
+}
+
+/**
+	Decodes all known fields of the UUID
+
+    Timestamp is filled only for Time-based UUID, node and clock sequence only for versions 1 and 6,
+    name only for the well-known UUIDs registered by RegisterName
+ */
+
+func (this UUID) Inspect() Info {
+
+	info := Info{
+		ID:      this.String(),
+		Version: this.Version().String(),
+		Variant: this.Variant().String(),
+		Encodings: Encodings{
+			Canonical: this.String(),
+			URN:       this.URN(),
+			Hex:       this.Hex(),
+			Base64:    this.Base64(),
+			Base64URL: this.Base64URL(),
+			Base58:    this.Base58(),
+			ULID:      this.ULID(),
+		},
+	}
+
+	info.Name, _ = NameOf(this)
+
+	switch this.Version() {
+
+	case TimebasedVer7:
+		info.Timestamp = time.UnixMilli(this.UnixTimeMillis()).UTC().Format(time.RFC3339Nano)
+
+	case TimebasedVer1, ReorderedTimebasedVer6:
+		unixTime100Nanos, _ := this.unixTime100Nanos()
+		clockSequence := this.ClockSequence()
+		info.Timestamp = time.Unix(unixTime100Nanos/one100NanosInSecond, (unixTime100Nanos%one100NanosInSecond)*100).UTC().Format(time.RFC3339Nano)
+		info.Node = fmt.Sprintf("%012x", this.Node())
+		info.ClockSequence = &clockSequence
+		if this.Version() == TimebasedVer1 {
+			if sortable, err := this.MarshalSortableBinary(); err == nil {
+				info.Encodings.SortableBinaryHex = hex.EncodeToString(sortable)
+			}
+		}
+
+	}
+
+	return info
+}
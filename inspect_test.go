@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInspect(t *testing.T) {
+
+	id := uuid.New(uuid.TimebasedVer1)
+	id.SetUnixTimeMillis(0)
+	id.SetClockSequence(0x1234)
+	id.SetNode(0x0000AABBCCDDEEFF)
+
+	info := id.Inspect()
+	assert.Equal(t, id.String(), info.ID)
+	assert.Equal(t, "TimebasedVer1", info.Version)
+	assert.Equal(t, "IETF", info.Variant)
+	assert.Equal(t, "1970-01-01T00:00:00Z", info.Timestamp)
+	assert.Equal(t, "aabbccddeeff", info.Node)
+	assert.Equal(t, 0x1234, *info.ClockSequence)
+	assert.Equal(t, id.URN(), info.Encodings.URN)
+	assert.Len(t, info.Encodings.SortableBinaryHex, 32)
+
+	// RFC 9562 appendix A.5
+	info = uuid.MustParse("1ec9414c-232a-6b00-b3c8-9f6bdeced846").Inspect()
+	assert.Equal(t, "ReorderedTimebasedVer6", info.Version)
+	assert.Equal(t, "2022-02-22T19:22:22Z", info.Timestamp)
+	assert.Equal(t, "9f6bdeced846", info.Node)
+	assert.Equal(t, 0x33c8, *info.ClockSequence)
+	assert.Empty(t, info.Encodings.SortableBinaryHex)
+
+	// the largest 48-bit millis do not fit into nanoseconds of int64
+	info = uuid.MustParse("ffffffff-ffff-7fff-bfff-ffffffffffff").Inspect()
+	assert.Equal(t, "10889-08-02T05:31:50.655Z", info.Timestamp)
+
+	id, err := uuid.NameUUIDFromBytes([]byte("alex"), uuid.NamebasedVer3)
+	assert.NoError(t, err)
+
+	info = id.Inspect()
+	assert.Equal(t, "NamebasedVer3", info.Version)
+	assert.Equal(t, "534b44a19bf13d20b71ecc4eb77c572f", info.Encodings.Hex)
+	assert.Equal(t, "U0tEoZvxPSC3HsxOt3xXLw==", info.Encodings.Base64)
+
+	data, err := json.Marshal(info)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "timestamp")
+	assert.NotContains(t, string(data), "clockSequence")
+
+}
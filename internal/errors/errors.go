@@ -0,0 +1,14 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+/**
+	Error creation used by all packages of the module
+
+    Backed by github.com/pkg/errors with stack traces by default, the build tag uuid_stdlib
+    switches to the standard library only: go build -tags uuid_stdlib.
+    Wrapped errors support errors.Is and errors.As in both builds.
+ */
+
+package errors
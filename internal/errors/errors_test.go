@@ -0,0 +1,28 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package errors_test
+
+import (
+	stderrors "errors"
+	"io"
+	"testing"
+
+	"github.com/codeallergy/uuid/internal/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrors(t *testing.T) {
+
+	assert.Equal(t, "boom", errors.New("boom").Error())
+	assert.Equal(t, "bad 7", errors.Errorf("bad %d", 7).Error())
+
+	assert.Nil(t, errors.Wrap(nil, "read"))
+	assert.Nil(t, errors.Wrapf(nil, "read %d", 1))
+
+	err := errors.Wrapf(errors.Wrap(io.EOF, "read"), "line %d", 3)
+	assert.Equal(t, "line 3: read: EOF", err.Error())
+	assert.True(t, stderrors.Is(err, io.EOF))
+}
@@ -0,0 +1,45 @@
+//go:build !uuid_stdlib
+// +build !uuid_stdlib
+
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package errors
+
+import (
+	"github.com/pkg/errors"
+)
+
+/**
+	Creates error with the message
+ */
+
+func New(message string) error {
+	return errors.New(message)
+}
+
+/**
+	Creates error with the formatted message
+ */
+
+func Errorf(format string, args ...interface{}) error {
+	return errors.Errorf(format, args...)
+}
+
+/**
+	Annotates error with the message, nil for nil error
+ */
+
+func Wrap(err error, message string) error {
+	return errors.Wrap(err, message)
+}
+
+/**
+	Annotates error with the formatted message, nil for nil error
+ */
+
+func Wrapf(err error, format string, args ...interface{}) error {
+	return errors.Wrapf(err, format, args...)
+}
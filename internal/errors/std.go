@@ -0,0 +1,73 @@
+//go:build uuid_stdlib
+// +build uuid_stdlib
+
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package errors
+
+import (
+	"errors"
+	"fmt"
+)
+
+/**
+	Creates error with the message
+ */
+
+func New(message string) error {
+	return errors.New(message)
+}
+
+/**
+	Creates error with the formatted message
+ */
+
+func Errorf(format string, args ...interface{}) error {
+	return fmt.Errorf(format, args...)
+}
+
+/**
+	Annotates error with the message, nil for nil error
+ */
+
+func Wrap(err error, message string) error {
+	if err == nil {
+		return nil
+	}
+	return &wrapped{message: message + ": " + err.Error(), cause: err}
+}
+
+/**
+	Annotates error with the formatted message, nil for nil error
+ */
+
+func Wrapf(err error, format string, args ...interface{}) error {
+	if err == nil {
+		return nil
+	}
+	return Wrap(err, fmt.Sprintf(format, args...))
+}
+
+type wrapped struct {
+	message string
+	cause   error
+}
+
+func (this *wrapped) Error() string {
+	return this.message
+}
+
+func (this *wrapped) Unwrap() error {
+	return this.cause
+}
+
+/**
+	Compatible with Cause of github.com/pkg/errors
+ */
+
+func (this *wrapped) Cause() error {
+	return this.cause
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"time"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Name of JWT ID claim, RFC 7519 section 4.1.7
+ */
+
+const JTIClaim = "jti"
+
+var (
+	ErrorMissingJTI = errors.New("missing jti claim")
+	ErrorJTITooOld  = errors.New("jti is older than allowed age")
+	ErrorJTIFuture  = errors.New("jti is issued in the future")
+)
+
+/**
+	Mints JWT ID claim value as version 7 UUID, so replay caches can expire entries by the embedded time
+ */
+
+func NewJTI() (string, error) {
+	id, err := NewV7()
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
+
+/**
+	Gets version 7 UUID from jti claim of the decoded claims map
+ */
+
+func JTIFromClaims(claims map[string]interface{}) (UUID, error) {
+
+	value, ok := claims[JTIClaim]
+	if !ok {
+		return Empty, ErrorMissingJTI
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return Empty, errors.Errorf("jti claim must be a string, got %T", value)
+	}
+
+	id, err := Parse(s)
+	if err != nil {
+		return Empty, errors.Wrap(err, "invalid jti claim")
+	}
+
+	if id.Version() != TimebasedVer7 {
+		return Empty, errors.Errorf("jti claim must be version 7 UUID, got %v", id.Version())
+	}
+
+	return id, nil
+}
+
+/**
+	Checks that the jti was minted within maxAge before now, leeway tolerates clock skew between issuer and verifier
+ */
+
+func ValidateJTI(id UUID, now time.Time, maxAge, leeway time.Duration) error {
+
+	// 48-bit millis overflow nanoseconds of time.Duration, so the future is checked before converting
+	millis := id.UnixTimeMillis()
+	if millis > now.Add(leeway).UnixMilli() {
+		return ErrorJTIFuture
+	}
+	issuedAt := time.UnixMilli(millis)
+
+	if now.Sub(issuedAt) > maxAge+leeway {
+		return ErrorJTITooOld
+	}
+
+	return nil
+}
+
+/**
+	Gets jti from the claims map and checks its age window
+ */
+
+func CheckJTI(claims map[string]interface{}, now time.Time, maxAge, leeway time.Duration) (UUID, error) {
+	id, err := JTIFromClaims(claims)
+	if err != nil {
+		return Empty, err
+	}
+	return id, ValidateJTI(id, now, maxAge, leeway)
+}
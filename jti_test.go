@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJTI(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	jti, err := uuid.NewJTI()
+	assert.NoError(t, err)
+
+	claims := map[string]interface{}{"jti": jti}
+	now := time.Now()
+
+	id, err := uuid.CheckJTI(claims, now, time.Minute, time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, jti, id.String())
+
+	_, err = uuid.CheckJTI(claims, now.Add(2*time.Minute), time.Minute, time.Second)
+	assert.Equal(t, uuid.ErrorJTITooOld, err)
+
+	_, err = uuid.CheckJTI(claims, now.Add(-time.Minute), time.Minute, time.Second)
+	assert.Equal(t, uuid.ErrorJTIFuture, err)
+
+	_, err = uuid.CheckJTI(map[string]interface{}{}, now, time.Minute, 0)
+	assert.Equal(t, uuid.ErrorMissingJTI, err)
+
+	_, err = uuid.JTIFromClaims(map[string]interface{}{"jti": 42})
+	assert.Error(t, err)
+
+	_, err = uuid.JTIFromClaims(map[string]interface{}{"jti": "not-uuid"})
+	assert.Error(t, err)
+
+	random, _ := uuid.RandomUUID()
+	_, err = uuid.JTIFromClaims(map[string]interface{}{"jti": random.String()})
+	assert.Error(t, err)
+
+}
+
+func TestValidateJTIMaxTimestamp(t *testing.T) {
+
+	// 48-bit millis of the year 10889 do not fit into time.Duration
+	id := uuid.MustParse("ffffffff-ffff-7fff-bfff-ffffffffffff")
+	assert.Equal(t, uuid.TimebasedVer7, id.Version())
+
+	now := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, uuid.ErrorJTIFuture, uuid.ValidateJTI(id, now, time.Minute, time.Second))
+	assert.Equal(t, uuid.ErrorJTIFuture, uuid.ValidateJTI(id, now, 1<<62, 1<<62))
+
+	zero := uuid.MustParse("00000000-0000-7000-8000-000000000000")
+	assert.Equal(t, uuid.ErrorJTITooOld, uuid.ValidateJTI(zero, now, time.Minute, time.Second))
+	assert.NoError(t, uuid.ValidateJTI(zero, time.UnixMilli(30000), time.Minute, time.Second))
+
+}
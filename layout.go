@@ -0,0 +1,187 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Layout mini-language describing string representation of UUID, similar to time.Format
+
+	X      one uppercase hex digit
+	x      one lowercase hex digit
+	{X32}  repeated hex digits, e.g. {x8}-{x4}-{x4}-{x4}-{x12}
+	D      canonical form, same as xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+	N      32 lowercase hex digits
+	\c     literal character c
+
+    Any other character is literal. Layout must contain exactly 32 hex digits.
+    Parsing accepts hex digits in any case.
+
+    Examples: "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX", "{X32}", "urn:uuid:D", "(0\\x{x8}_{x8}_{x8}_{x8})"
+ */
+
+var hexIndex = buildIndex("0123456789ABCDEF", true)
+
+type layoutToken struct {
+	literal string
+	digits  int
+	upper   bool
+}
+
+/**
+	Formats UUID according to the layout
+ */
+
+func (this UUID) FormatLayout(layout string) (string, error) {
+
+	tokens, err := compileLayout(layout)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	hi, lo := this.MostSigBits, this.LeastSigBits
+
+	for _, token := range tokens {
+		if token.digits == 0 {
+			sb.WriteString(token.literal)
+			continue
+		}
+		for i := 0; i < token.digits; i++ {
+			nibble := hi >> 60
+			hi = (hi << 4) | (lo >> 60)
+			lo <<= 4
+			c := hexDigits[nibble]
+			if token.upper && c >= 'a' {
+				c -= 'a' - 'A'
+			}
+			sb.WriteByte(c)
+		}
+	}
+
+	return sb.String(), nil
+}
+
+/**
+	Parses UUID represented according to the layout
+ */
+
+func ParseLayout(layout, s string) (UUID, error) {
+
+	tokens, err := compileLayout(layout)
+	if err != nil {
+		return Empty, err
+	}
+
+	var hi, lo uint64
+	pos := 0
+
+	for _, token := range tokens {
+
+		if token.digits == 0 {
+			if !strings.HasPrefix(s[pos:], token.literal) {
+				return Empty, errors.Errorf("expected %q at position %d of %q", token.literal, pos, s)
+			}
+			pos += len(token.literal)
+			continue
+		}
+
+		if len(s)-pos < token.digits {
+			return Empty, errors.Errorf("unexpected end of %q", s)
+		}
+
+		for i := 0; i < token.digits; i++ {
+			nibble := hexIndex[s[pos]]
+			if nibble < 0 {
+				return Empty, errors.Errorf("invalid hex digit %q at position %d of %q", s[pos], pos, s)
+			}
+			hi = (hi << 4) | (lo >> 60)
+			lo = (lo << 4) | uint64(nibble)
+			pos++
+		}
+	}
+
+	if pos != len(s) {
+		return Empty, errors.Errorf("unexpected trailing characters in %q", s)
+	}
+
+	return UUID{MostSigBits: hi, LeastSigBits: lo}, nil
+}
+
+func compileLayout(layout string) ([]layoutToken, error) {
+
+	var tokens []layoutToken
+	var literal strings.Builder
+	total := 0
+
+	flush := func() {
+		if literal.Len() > 0 {
+			tokens = append(tokens, layoutToken{literal: literal.String()})
+			literal.Reset()
+		}
+	}
+
+	digits := func(n int, upper bool) {
+		flush()
+		tokens = append(tokens, layoutToken{digits: n, upper: upper})
+		total += n
+	}
+
+	for i := 0; i < len(layout); i++ {
+
+		switch c := layout[i]; c {
+
+		case 'X', 'x':
+			digits(1, c == 'X')
+
+		case 'D':
+			for j, n := range []int{8, 4, 4, 4, 12} {
+				if j > 0 {
+					literal.WriteByte('-')
+				}
+				digits(n, false)
+			}
+
+		case 'N':
+			digits(32, false)
+
+		case '{':
+			end := strings.IndexByte(layout[i:], '}')
+			if end < 3 || (layout[i+1] != 'X' && layout[i+1] != 'x') {
+				return nil, errors.Errorf("invalid repetition at position %d of layout %q", i, layout)
+			}
+			n, err := strconv.Atoi(layout[i+2 : i+end])
+			if err != nil || n <= 0 || n > 32 {
+				return nil, errors.Errorf("invalid repetition count at position %d of layout %q", i, layout)
+			}
+			digits(n, layout[i+1] == 'X')
+			i += end
+
+		case '\\':
+			if i+1 == len(layout) {
+				return nil, errors.Errorf("trailing escape in layout %q", layout)
+			}
+			i++
+			literal.WriteByte(layout[i])
+
+		default:
+			literal.WriteByte(c)
+		}
+	}
+
+	flush()
+
+	if total != 32 {
+		return nil, errors.Errorf("layout %q has %d hex digits instead of 32", layout, total)
+	}
+
+	return tokens, nil
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLayout(t *testing.T) {
+
+	id, _ := uuid.Parse("534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+
+	cases := map[string]string{
+		"XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX": "534B44A1-9BF1-3D20-B71E-CC4EB77C572F",
+		"{X32}":                                "534B44A19BF13D20B71ECC4EB77C572F",
+		"urn:uuid:D":                           "urn:uuid:534b44a1-9bf1-3d20-b71e-cc4eb77c572f",
+		"N":                                    "534b44a19bf13d20b71ecc4eb77c572f",
+		"(0\\x{x8}_{x8}_{x8}_{x8})":              "(0x534b44a1_9bf13d20_b71ecc4e_b77c572f)",
+		"\\X\\D{x16}/{X16}":                    "XD534b44a19bf13d20/B71ECC4EB77C572F",
+	}
+
+	for layout, expected := range cases {
+
+		s, err := id.FormatLayout(layout)
+		assert.NoError(t, err, layout)
+		assert.Equal(t, expected, s, layout)
+
+		actual, err := uuid.ParseLayout(layout, s)
+		assert.NoError(t, err, layout)
+		assert.True(t, id.Equal(actual), layout)
+	}
+
+	// any case on parsing
+	actual, err := uuid.ParseLayout("{X32}", "534b44a19bf13d20b71ecc4eb77c572f")
+	assert.NoError(t, err)
+	assert.True(t, id.Equal(actual))
+
+	for _, layout := range []string{"{X31}", "D-x", "{X}", "{Y32}", "N\\"} {
+		_, err := id.FormatLayout(layout)
+		assert.Error(t, err, layout)
+	}
+
+	for _, s := range []string{"urn:uuid:534b44a1", "urn:uuid:534b44a1-9bf1-3d20-b71e-cc4eb77c572f!", "uri:uuid:534b44a1-9bf1-3d20-b71e-cc4eb77c572f", "urn:uuid:534b44a1-9bf1-3d20-b71e-cc4eb77c572g"} {
+		_, err := uuid.ParseLayout("urn:uuid:D", s)
+		assert.Error(t, err, s)
+	}
+
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/**
+	Form of Go source text returned by GoLiteral
+ */
+
+type LiteralStyle int
+
+const (
+
+	/**
+		Composite literal uuid.UUID{MostSigBits: 0x..., LeastSigBits: 0x...}, usable in constant-like var blocks without init cost, default
+	 */
+
+	LiteralStruct LiteralStyle = iota
+
+	/**
+		Call uuid.MustParse("...") keeping the canonical string readable in the generated code
+	 */
+
+	LiteralMustParse
+)
+
+var literalStyleNames = []string{"struct", "mustparse"}
+
+func (s LiteralStyle) String() string {
+	if s >= 0 && int(s) < len(literalStyleNames) {
+		return literalStyleNames[s]
+	}
+	return fmt.Sprintf("LiteralStyle(%d)", int(s))
+}
+
+/**
+	Gets Go source text of the UUID for code generators baking known IDs into generated files
+
+    Output assumes the package is imported as uuid, e.g. uuid.UUID{MostSigBits: 0x6ba7b8109dad11d1, LeastSigBits: 0x80b400c04fd430c8}
+ */
+
+func (this UUID) GoLiteral(style LiteralStyle) string {
+	switch style {
+	case LiteralMustParse:
+		return "uuid.MustParse(" + strconv.Quote(this.String()) + ")"
+	default:
+		return fmt.Sprintf("uuid.UUID{MostSigBits: 0x%016x, LeastSigBits: 0x%016x}", this.MostSigBits, this.LeastSigBits)
+	}
+}
+
+/**
+	Parses UUID or panics, used by package-level variables and generated code
+ */
+
+func MustParse(s string) UUID {
+	id, err := Parse(s)
+	if err != nil {
+		panic(fmt.Sprintf("uuid: MustParse(%q): %v", s, err))
+	}
+	return id
+}
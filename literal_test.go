@@ -0,0 +1,28 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGoLiteral(t *testing.T) {
+
+	id := uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+	assert.Equal(t, "uuid.UUID{MostSigBits: 0x6ba7b8109dad11d1, LeastSigBits: 0x80b400c04fd430c8}", id.GoLiteral(uuid.LiteralStruct))
+	assert.Equal(t, `uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")`, id.GoLiteral(uuid.LiteralMustParse))
+	assert.Equal(t, "uuid.UUID{MostSigBits: 0x0000000000000000, LeastSigBits: 0x0000000000000000}", uuid.Empty.GoLiteral(uuid.LiteralStruct))
+
+	// literal compiles to the same value
+	assert.Equal(t, id, uuid.UUID{MostSigBits: 0x6ba7b8109dad11d1, LeastSigBits: 0x80b400c04fd430c8})
+
+	assert.Equal(t, "mustparse", uuid.LiteralMustParse.String())
+	assert.Panics(t, func() { uuid.MustParse("bad") })
+}
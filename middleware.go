@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+var ErrorRejected = errors.New("generated UUID rejected")
+
+/**
+	Max number of re-rolls of the rejected UUID by Reject middleware
+ */
+
+const DefaultMaxRerolls = 16
+
+/**
+	Adapter of the function to the Generator interface
+ */
+
+type GeneratorFunc func() (UUID, error)
+
+/**
+	Calls the function
+
+    Next implements the Generator interface.
+ */
+
+func (f GeneratorFunc) Next() (UUID, error) {
+	return f()
+}
+
+/**
+	Wraps generator with the extra behavior, e.g. validation or stamping of the generated UUIDs
+ */
+
+type Middleware func(next Generator) Generator
+
+/**
+	Wraps generator by the middlewares, the first middleware is the outermost one
+ */
+
+func Chain(g Generator, middlewares ...Middleware) Generator {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		g = middlewares[i](g)
+	}
+	return g
+}
+
+/**
+	Fails with ErrorRejected on UUIDs of other versions
+ */
+
+func AllowVersions(versions ...Version) Middleware {
+	return func(next Generator) Generator {
+		return GeneratorFunc(func() (UUID, error) {
+			id, err := next.Next()
+			if err != nil {
+				return id, err
+			}
+			for _, version := range versions {
+				if id.Version() == version {
+					return id, nil
+				}
+			}
+			return Empty, errors.Wrapf(ErrorRejected, "version %d is not allowed", id.Version())
+		})
+	}
+}
+
+/**
+	Re-rolls UUIDs matching the predicate, fails with ErrorRejected after DefaultMaxRerolls attempts
+ */
+
+func Reject(match func(UUID) bool) Middleware {
+	return func(next Generator) Generator {
+		return GeneratorFunc(func() (UUID, error) {
+			for i := 0; i < DefaultMaxRerolls; i++ {
+				id, err := next.Next()
+				if err != nil || !match(id) {
+					return id, err
+				}
+			}
+			return Empty, errors.Wrapf(ErrorRejected, "%d attempts", DefaultMaxRerolls)
+		})
+	}
+}
+
+/**
+	Overwrites the most significant bits of UUIDs by the prefix, e.g. tenant or region tag
+
+    Bits must be in [1, 48] and the prefix must fit them, so version and variant are not touched. For version 7 UUIDs the prefix
+    replaces the high bits of the timestamp, the order is kept only within the prefix.
+ */
+
+func StampPrefix(prefix uint64, bits int) (Middleware, error) {
+	if bits < 1 || bits > 48 {
+		return nil, errors.Errorf("prefix bits %d out of [1, 48]", bits)
+	}
+	if prefix >= 1<<uint(bits) {
+		return nil, errors.Errorf("prefix %#x does not fit %d bits", prefix, bits)
+	}
+	shift := uint(64 - bits)
+	mask := ^uint64(0) << shift
+	value := prefix << shift
+	return func(next Generator) Generator {
+		return GeneratorFunc(func() (UUID, error) {
+			id, err := next.Next()
+			if err != nil {
+				return id, err
+			}
+			id.MostSigBits = id.MostSigBits&^mask | value
+			return id, nil
+		})
+	}, nil
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChain(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	var order []string
+	trace := func(name string) uuid.Middleware {
+		return func(next uuid.Generator) uuid.Generator {
+			return uuid.GeneratorFunc(func() (uuid.UUID, error) {
+				order = append(order, name)
+				return next.Next()
+			})
+		}
+	}
+
+	g := uuid.Chain(uuid.NewRandomGenerator(), trace("outer"), trace("inner"))
+	_, err := g.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"outer", "inner"}, order)
+
+	g = uuid.Chain(uuid.NewRandomGenerator())
+	_, err = g.Next()
+	assert.NoError(t, err)
+}
+
+func TestAllowVersions(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	g := uuid.Chain(uuid.NewRandomGenerator(), uuid.AllowVersions(uuid.RandomlyGeneratedVer4, uuid.TimebasedVer7))
+	_, err := g.Next()
+	assert.NoError(t, err)
+
+	g = uuid.Chain(uuid.NewRandomGenerator(), uuid.AllowVersions(uuid.TimebasedVer7))
+	_, err = g.Next()
+	assert.True(t, errors.Is(err, uuid.ErrorRejected))
+}
+
+func TestReject(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	calls := 0
+	g := uuid.Chain(uuid.NewRandomGenerator(), uuid.Reject(func(uuid.UUID) bool {
+		calls++
+		return calls < 3
+	}))
+	_, err := g.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+
+	g = uuid.Chain(uuid.NewRandomGenerator(), uuid.Reject(func(uuid.UUID) bool { return true }))
+	_, err = g.Next()
+	assert.True(t, errors.Is(err, uuid.ErrorRejected))
+}
+
+func TestStampPrefix(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	stamp, err := uuid.StampPrefix(0xAB, 8)
+	assert.NoError(t, err)
+
+	g := uuid.Chain(uuid.NewRandomGenerator(), stamp, uuid.AllowVersions(uuid.RandomlyGeneratedVer4))
+	for i := 0; i < 10; i++ {
+		id, err := g.Next()
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(0xAB), id.MostSigBits>>56)
+		assert.Equal(t, uuid.RandomlyGeneratedVer4, id.Version())
+	}
+
+	_, err = uuid.StampPrefix(0x1AB, 8)
+	assert.Error(t, err)
+
+	_, err = uuid.StampPrefix(1, 49)
+	assert.Error(t, err)
+}
@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"time"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+var ErrorMigrationMismatch = errors.New("migration record mismatch")
+
+/**
+	Origin of the new-scheme ID in the migration record
+ */
+
+type MigrationSource int
+
+const (
+
+	/**
+		Both IDs are minted for the new entity
+	 */
+
+	MigrationMinted MigrationSource = iota
+
+	/**
+		New ID is mapped from the existing old ID, e.g. backfill
+	 */
+
+	MigrationMapped
+)
+
+/**
+	Paired IDs of one entity in the old and the new key scheme, kept to verify the dual-write phase
+ */
+
+type MigrationRecord struct {
+	Old    UUID
+	New    UUID
+	Source MigrationSource
+	At     time.Time
+}
+
+/**
+	Dual-write helper for the migration between key schemes, e.g. from version 4 to version 7 primary keys
+
+    New entities get IDs of both schemes from the generators, existing entities get the new ID
+    from the deterministic mapping, e.g. Rekeyer. Every record is passed to the journal when it is set,
+    a journal error fails the call, so no ID is used without its record.
+ */
+
+type Migrator struct {
+	old     Generator
+	new     Generator
+	mapping func(old UUID) UUID
+	journal func(MigrationRecord) error
+	now     func() time.Time
+}
+
+/**
+	Creates migrator from the generators of both schemes and the mapping of the old IDs to the new ones
+ */
+
+func NewMigrator(old, new Generator, mapping func(old UUID) UUID) (*Migrator, error) {
+	if old == nil || new == nil {
+		return nil, errors.New("nil migration generator")
+	}
+	if mapping == nil {
+		return nil, errors.New("nil migration mapping")
+	}
+	return &Migrator{old: old, new: new, mapping: mapping, now: time.Now}, nil
+}
+
+/**
+	Sets journal of the migration records, e.g. the verification table
+ */
+
+func (this *Migrator) SetJournal(journal func(MigrationRecord) error) {
+	this.journal = journal
+}
+
+/**
+	Sets source of the record time, time.Now by default
+ */
+
+func (this *Migrator) SetClock(now func() time.Time) {
+	this.now = now
+}
+
+/**
+	Mints IDs of both schemes for the new entity
+ */
+
+func (this *Migrator) Next() (MigrationRecord, error) {
+	old, err := this.old.Next()
+	if err != nil {
+		return MigrationRecord{}, errors.Wrap(err, "old scheme")
+	}
+	new, err := this.new.Next()
+	if err != nil {
+		return MigrationRecord{}, errors.Wrap(err, "new scheme")
+	}
+	return this.record(MigrationRecord{Old: old, New: new, Source: MigrationMinted, At: this.now()})
+}
+
+/**
+	Maps the old ID of the existing entity to the new scheme
+ */
+
+func (this *Migrator) Map(old UUID) (MigrationRecord, error) {
+	return this.record(MigrationRecord{Old: old, New: this.mapping(old), Source: MigrationMapped, At: this.now()})
+}
+
+/**
+	Checks the record read back from the storage, ErrorMigrationMismatch for mapped records not matching the mapping
+ */
+
+func (this *Migrator) Verify(r MigrationRecord) error {
+	if r.Old == Empty || r.New == Empty || r.Old == r.New {
+		return errors.Wrapf(ErrorMigrationMismatch, "invalid pair %v -> %v", r.Old, r.New)
+	}
+	if r.Source == MigrationMapped {
+		if expected := this.mapping(r.Old); expected != r.New {
+			return errors.Wrapf(ErrorMigrationMismatch, "%v maps to %v, not %v", r.Old, expected, r.New)
+		}
+	}
+	return nil
+}
+
+func (this *Migrator) record(r MigrationRecord) (MigrationRecord, error) {
+	if err := this.Verify(r); err != nil {
+		return MigrationRecord{}, err
+	}
+	if this.journal != nil {
+		if err := this.journal(r); err != nil {
+			return MigrationRecord{}, errors.Wrap(err, "migration journal")
+		}
+	}
+	return r, nil
+}
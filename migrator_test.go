@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigrator(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	rekeyer, err := uuid.NewRekeyer([]byte("migration"))
+	assert.NoError(t, err)
+	createdAt := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	mapping := func(old uuid.UUID) uuid.UUID {
+		return rekeyer.Rekey(old, createdAt)
+	}
+
+	v7, err := uuid.NewGenerator(uuid.TimebasedVer7)
+	assert.NoError(t, err)
+
+	_, err = uuid.NewMigrator(nil, v7, mapping)
+	assert.Error(t, err)
+	_, err = uuid.NewMigrator(uuid.NewRandomGenerator(), v7, nil)
+	assert.Error(t, err)
+
+	m, err := uuid.NewMigrator(uuid.NewRandomGenerator(), v7, mapping)
+	assert.NoError(t, err)
+
+	var journal []uuid.MigrationRecord
+	m.SetJournal(func(r uuid.MigrationRecord) error {
+		journal = append(journal, r)
+		return nil
+	})
+
+	minted, err := m.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.MigrationMinted, minted.Source)
+	assert.Equal(t, uuid.RandomlyGeneratedVer4, minted.Old.Version())
+	assert.Equal(t, uuid.TimebasedVer7, minted.New.Version())
+
+	old, _ := uuid.RandomUUID()
+	mapped, err := m.Map(old)
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.MigrationMapped, mapped.Source)
+	assert.Equal(t, mapping(old), mapped.New)
+
+	assert.Equal(t, []uuid.MigrationRecord{minted, mapped}, journal)
+	for _, r := range journal {
+		assert.NoError(t, m.Verify(r))
+	}
+
+	tampered := mapped
+	tampered.New = minted.New
+	assert.True(t, errors.Is(m.Verify(tampered), uuid.ErrorMigrationMismatch))
+	assert.True(t, errors.Is(m.Verify(uuid.MigrationRecord{Old: old}), uuid.ErrorMigrationMismatch))
+
+	m.SetJournal(func(uuid.MigrationRecord) error {
+		return errors.New("down")
+	})
+	_, err = m.Map(old)
+	assert.Error(t, err)
+}
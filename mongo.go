@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"encoding/binary"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Byte order of legacy MongoDB UUIDs stored as BSON binary subtype 3
+
+    The BSON codec for the driver registries lives in the github.com/codeallergy/uuid/uuidmongo module.
+ */
+
+type MongoFlavor int
+
+const (
+
+	/**
+		Python legacy driver, bytes as is in big-endian order
+	 */
+
+	MongoPythonLegacy = MongoFlavor(iota)
+
+	/**
+		Java legacy driver, each 8-byte half reversed
+	 */
+
+	MongoJavaLegacy
+
+	/**
+		C# legacy driver, System.Guid layout with the first three groups reversed
+	 */
+
+	MongoCSharpLegacy
+)
+
+/**
+	Gets flavor name
+ */
+
+func (f MongoFlavor) String() string {
+	switch f {
+	case MongoPythonLegacy:
+		return "PythonLegacy"
+	case MongoJavaLegacy:
+		return "JavaLegacy"
+	case MongoCSharpLegacy:
+		return "CSharpLegacy"
+	}
+	return "UnknownFlavor"
+}
+
+/**
+	Convert 16 bytes of the legacy subtype 3 binary written by the specific driver to UUID
+ */
+
+func FromLegacyMongo(data []byte, flavor MongoFlavor) (UUID, error) {
+
+	if len(data) != 16 {
+		return Empty, ErrorWrongLen
+	}
+
+	switch flavor {
+	case MongoPythonLegacy:
+		var id UUID
+		err := id.UnmarshalBinary(data)
+		return id, err
+	case MongoJavaLegacy:
+		return UUID{
+			MostSigBits:  binary.LittleEndian.Uint64(data),
+			LeastSigBits: binary.LittleEndian.Uint64(data[8:]),
+		}, nil
+	case MongoCSharpLegacy:
+		return UUID{
+			MostSigBits: uint64(binary.LittleEndian.Uint32(data))<<32 |
+				uint64(binary.LittleEndian.Uint16(data[4:]))<<16 |
+				uint64(binary.LittleEndian.Uint16(data[6:])),
+			LeastSigBits: binary.BigEndian.Uint64(data[8:]),
+		}, nil
+	default:
+		return Empty, errors.Errorf("unknown legacy MongoDB flavor: %d", int(flavor))
+	}
+}
+
+/**
+	Stores UUID in to 16 bytes of the legacy subtype 3 binary for the specific driver
+ */
+
+func ToLegacyMongo(id UUID, flavor MongoFlavor) ([]byte, error) {
+
+	dst := make([]byte, 16)
+
+	switch flavor {
+	case MongoPythonLegacy:
+		id.MarshalBinaryTo(dst)
+	case MongoJavaLegacy:
+		binary.LittleEndian.PutUint64(dst, id.MostSigBits)
+		binary.LittleEndian.PutUint64(dst[8:], id.LeastSigBits)
+	case MongoCSharpLegacy:
+		binary.LittleEndian.PutUint32(dst, uint32(id.MostSigBits>>32))
+		binary.LittleEndian.PutUint16(dst[4:], uint16(id.MostSigBits>>16))
+		binary.LittleEndian.PutUint16(dst[6:], uint16(id.MostSigBits))
+		binary.BigEndian.PutUint64(dst[8:], id.LeastSigBits)
+	default:
+		return nil, errors.Errorf("unknown legacy MongoDB flavor: %d", int(flavor))
+	}
+
+	return dst, nil
+}
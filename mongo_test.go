@@ -0,0 +1,43 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLegacyMongo(t *testing.T) {
+
+	id, _ := uuid.Parse("00112233-4455-6677-8899-aabbccddeeff")
+
+	expected := map[uuid.MongoFlavor]string{
+		uuid.MongoPythonLegacy: "00112233445566778899aabbccddeeff",
+		uuid.MongoJavaLegacy:   "7766554433221100ffeeddccbbaa9988",
+		uuid.MongoCSharpLegacy: "33221100554477668899aabbccddeeff",
+	}
+
+	for flavor, hexData := range expected {
+
+		data, err := uuid.ToLegacyMongo(id, flavor)
+		assert.NoError(t, err)
+		assert.Equal(t, hexData, hex.EncodeToString(data), flavor.String())
+
+		actual, err := uuid.FromLegacyMongo(data, flavor)
+		assert.NoError(t, err)
+		assert.True(t, id.Equal(actual), flavor.String())
+	}
+
+	_, err := uuid.FromLegacyMongo(make([]byte, 16), uuid.MongoFlavor(42))
+	assert.Error(t, err)
+
+	_, err = uuid.FromLegacyMongo(make([]byte, 8), uuid.MongoJavaLegacy)
+	assert.Equal(t, uuid.ErrorWrongLen, err)
+
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"sync"
+	"time"
+)
+
+/**
+	Defaults of the monotonic clock used by GeneratorConfig
+ */
+
+const (
+	DefaultMonotonicResync   = time.Minute
+	DefaultMonotonicMaxDrift = time.Second
+)
+
+/**
+	Clock that reads wall time once and then advances by the monotonic clock, immune to wall clock jumps
+
+    Every resync interval the wall clock is read again. If the wall clock is ahead, the clock jumps forward to it.
+    If the wall clock is behind by no more than max drift, the clock keeps running and the wall clock catches up,
+    larger differences are accepted as is and handled by ClockRegressionPolicy of the generator.
+    Negative max drift never accepts the wall clock behind.
+ */
+
+type MonotonicClock struct {
+	sync.Mutex
+
+	wall     func() time.Time
+	resync   time.Duration
+	maxDrift time.Duration
+
+	/**
+		Time reported at the anchor and the local reading carrying the monotonic clock
+	 */
+
+	anchor    time.Time
+	monotonic time.Time
+}
+
+/**
+	Creates monotonic clock anchored at the wall clock, time.Now if nil
+
+    Zero resync interval never reads the wall clock again
+ */
+
+func NewMonotonicClock(wall func() time.Time, resync, maxDrift time.Duration) *MonotonicClock {
+	if wall == nil {
+		wall = time.Now
+	}
+	return &MonotonicClock{
+		wall:      wall,
+		resync:    resync,
+		maxDrift:  maxDrift,
+		anchor:    wall().Round(0),
+		monotonic: time.Now(),
+	}
+}
+
+/**
+	Gets current time
+ */
+
+func (this *MonotonicClock) Now() time.Time {
+
+	this.Lock()
+	defer this.Unlock()
+
+	elapsed := time.Since(this.monotonic)
+	now := this.anchor.Add(elapsed)
+
+	if this.resync <= 0 || elapsed < this.resync {
+		return now
+	}
+
+	wall := this.wall().Round(0)
+	if wall.Before(now) && (this.maxDrift < 0 || now.Sub(wall) <= this.maxDrift) {
+		wall = now
+	}
+
+	this.anchor = wall
+	this.monotonic = time.Now()
+	return wall
+}
+
+/**
+	Gets difference between the wall clock and this clock, positive if the wall clock is ahead
+ */
+
+func (this *MonotonicClock) Drift() time.Duration {
+	this.Lock()
+	defer this.Unlock()
+	return this.wall().Round(0).Sub(this.anchor.Add(time.Since(this.monotonic)))
+}
+
+/**
+	Switches the generator to monotonic clock anchored at the current wall clock
+ */
+
+func (this *TimeGenerator) SetMonotonic(resync, maxDrift time.Duration) {
+	this.SetClock(NewMonotonicClock(nil, resync, maxDrift).Now)
+}
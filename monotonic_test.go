@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMonotonicClock(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	wall := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := uuid.NewMonotonicClock(func() time.Time { return wall }, 0, 0)
+
+	first := clock.Now()
+	wall = wall.Add(-time.Hour)
+	time.Sleep(time.Millisecond)
+	second := clock.Now()
+	assert.True(t, second.After(first))
+	assert.True(t, second.Sub(first) < time.Minute)
+	assert.True(t, clock.Drift() < -59*time.Minute)
+
+	wall = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock = uuid.NewMonotonicClock(func() time.Time { return wall }, time.Millisecond, time.Second)
+
+	wall = wall.Add(time.Hour)
+	time.Sleep(2 * time.Millisecond)
+	assert.Equal(t, wall, clock.Now())
+
+	wall = wall.Add(-500 * time.Millisecond)
+	time.Sleep(2 * time.Millisecond)
+	now := clock.Now()
+	assert.True(t, now.After(wall.Add(500*time.Millisecond)))
+
+	wall = wall.Add(-time.Hour)
+	time.Sleep(2 * time.Millisecond)
+	assert.Equal(t, wall, clock.Now())
+
+	gen, err := uuid.NewTimeGenerator(uuid.TimebasedVer7)
+	assert.NoError(t, err)
+	gen.SetMonotonic(uuid.DefaultMonotonicResync, uuid.DefaultMonotonicMaxDrift)
+	id, err := gen.Next()
+	assert.NoError(t, err)
+	assert.True(t, time.Since(time.UnixMilli(id.UnixTimeMillis())) < time.Minute)
+
+}
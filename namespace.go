@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"hash"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Predefined namespaces of RFC 4122 appendix C
+ */
+
+var (
+	NamespaceDNS  = UUID{MostSigBits: 0x6ba7b8109dad11d1, LeastSigBits: 0x80b400c04fd430c8}
+	NamespaceURL  = UUID{MostSigBits: 0x6ba7b8119dad11d1, LeastSigBits: 0x80b400c04fd430c8}
+	NamespaceOID  = UUID{MostSigBits: 0x6ba7b8129dad11d1, LeastSigBits: 0x80b400c04fd430c8}
+	NamespaceX500 = UUID{MostSigBits: 0x6ba7b8149dad11d1, LeastSigBits: 0x80b400c04fd430c8}
+)
+
+/**
+	Creates name-based UUID as defined in RFC 4122 section 4.3, digest of namespace followed by name
+
+    Unlike NameUUIDFromBytes that hashes only the name like Java does, the result is interoperable
+    with google/uuid, Python and other RFC-conforming implementations
+ */
+
+func NewNameBased(namespace UUID, name []byte, version Version) (uuid UUID, err error) {
+
+	var h hash.Hash
+	switch version {
+	case NamebasedVer3:
+		h = md5.New()
+	case NamebasedVer5:
+		h = sha1.New()
+	default:
+		return Empty, errors.Errorf("unknown namebased version: %q", version)
+	}
+
+	var ns [16]byte
+	namespace.MarshalBinaryTo(ns[:])
+	h.Write(ns[:])
+	h.Write(name)
+
+	var digest [sha1.Size]byte
+	h.Sum(digest[:0])
+
+	digest[6] &= 0x0f               /* clear version        */
+	digest[6] |= byte(version) << 4 /* set to version       */
+	digest[8] &= 0x3f               /* clear variant        */
+	digest[8] |= 0x80               /* set to IETF variant  */
+
+	err = uuid.UnmarshalBinary(digest[:16])
+	return uuid, err
+}
+
+/**
+	Creates version 3 UUID from the namespace and name
+ */
+
+func NewMD5(namespace UUID, name []byte) UUID {
+	uuid, _ := NewNameBased(namespace, name, NamebasedVer3)
+	return uuid
+}
+
+/**
+	Creates version 5 UUID from the namespace and name
+ */
+
+func NewSHA1(namespace UUID, name []byte) UUID {
+	uuid, _ := NewNameBased(namespace, name, NamebasedVer5)
+	return uuid
+}
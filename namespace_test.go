@@ -0,0 +1,32 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNameBased(t *testing.T) {
+
+	assert.Equal(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", uuid.NamespaceDNS.String())
+	assert.Equal(t, "6ba7b814-9dad-11d1-80b4-00c04fd430c8", uuid.NamespaceX500.String())
+
+	id := uuid.NewMD5(uuid.NamespaceDNS, []byte("python.org"))
+	assert.Equal(t, "6fa459ea-ee8a-3ca4-894e-db77e160355e", id.String())
+	assert.Equal(t, uuid.NamebasedVer3, id.Version())
+
+	id = uuid.NewSHA1(uuid.NamespaceDNS, []byte("python.org"))
+	assert.Equal(t, "886313e1-3b8a-5372-9b90-0c9aee199e5d", id.String())
+	assert.Equal(t, uuid.NamebasedVer5, id.Version())
+	assert.Equal(t, uuid.IETF, id.Variant())
+
+	_, err := uuid.NewNameBased(uuid.NamespaceDNS, []byte("python.org"), uuid.RandomlyGeneratedVer4)
+	assert.Error(t, err)
+
+}
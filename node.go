@@ -0,0 +1,197 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Metadata endpoints and files used by the node sources
+ */
+
+const (
+	DefaultEC2MetadataEndpoint = "http://169.254.169.254"
+	DefaultGCEMetadataEndpoint = "http://metadata.google.internal"
+	DefaultCgroupFile          = "/proc/self/cgroup"
+	DefaultMountInfoFile       = "/proc/self/mountinfo"
+
+	metadataTimeout = 2 * time.Second
+)
+
+/**
+	Source of the stable identity of the host, pod or container, hashed into the 48-bit node
+ */
+
+type NodeSource func(ctx context.Context) (identity string, err error)
+
+var containerIDPattern = regexp.MustCompile(`[0-9a-f]{64}`)
+
+/**
+	Hashes identity into the 48-bit node with the multicast bit set as RFC 4122 section 4.5 requires
+ */
+
+func HashNode(identity string) int64 {
+	digest := sha256.Sum256([]byte(identity))
+	var buf [8]byte
+	copy(buf[2:], digest[:6])
+	return int64(binary.BigEndian.Uint64(buf[:])) | 0x010000000000
+}
+
+/**
+	Gets node from the first source that succeeds
+ */
+
+func NodeFrom(ctx context.Context, sources ...NodeSource) (int64, error) {
+	var errs []string
+	for _, source := range sources {
+		identity, err := source(ctx)
+		if err == nil && identity != "" {
+			return HashNode(identity), nil
+		}
+		if err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	return 0, errors.Errorf("no node source succeeded: %s", strings.Join(errs, "; "))
+}
+
+/**
+	Gets node source reading the first non-empty environment variable
+ */
+
+func NodeFromEnv(names ...string) NodeSource {
+	return func(ctx context.Context) (string, error) {
+		for _, name := range names {
+			if value := strings.TrimSpace(os.Getenv(name)); value != "" {
+				return "env:" + value, nil
+			}
+		}
+		return "", errors.Errorf("environment variables %v are not set", names)
+	}
+}
+
+/**
+	Gets node source of Kubernetes pod UID exposed by the downward API as POD_UID or KUBERNETES_POD_UID
+ */
+
+func NodeFromKubernetes() NodeSource {
+	source := NodeFromEnv("POD_UID", "KUBERNETES_POD_UID")
+	return func(ctx context.Context) (string, error) {
+		identity, err := source(ctx)
+		if err != nil {
+			return "", err
+		}
+		return "k8s:" + strings.TrimPrefix(identity, "env:"), nil
+	}
+}
+
+/**
+	Gets node source of EC2 instance ID from the instance metadata service, IMDSv2
+ */
+
+func NodeFromEC2(endpoint string) NodeSource {
+	return func(ctx context.Context) (string, error) {
+		ctx, cancel := context.WithTimeout(ctx, metadataTimeout)
+		defer cancel()
+
+		token, err := fetchMetadata(ctx, http.MethodPut, endpoint+"/latest/api/token", "X-aws-ec2-metadata-token-ttl-seconds", "60")
+		if err != nil {
+			return "", errors.Wrap(err, "ec2 metadata token")
+		}
+		id, err := fetchMetadata(ctx, http.MethodGet, endpoint+"/latest/meta-data/instance-id", "X-aws-ec2-metadata-token", token)
+		if err != nil {
+			return "", errors.Wrap(err, "ec2 instance id")
+		}
+		return "ec2:" + id, nil
+	}
+}
+
+/**
+	Gets node source of GCE instance ID from the metadata server
+ */
+
+func NodeFromGCE(endpoint string) NodeSource {
+	return func(ctx context.Context) (string, error) {
+		ctx, cancel := context.WithTimeout(ctx, metadataTimeout)
+		defer cancel()
+
+		id, err := fetchMetadata(ctx, http.MethodGet, endpoint+"/computeMetadata/v1/instance/id", "Metadata-Flavor", "Google")
+		if err != nil {
+			return "", errors.Wrap(err, "gce instance id")
+		}
+		return "gce:" + id, nil
+	}
+}
+
+/**
+	Gets node source of the container ID found in cgroup or mountinfo files, Docker and containerd layouts
+ */
+
+func NodeFromContainer(files ...string) NodeSource {
+	if len(files) == 0 {
+		files = []string{DefaultCgroupFile, DefaultMountInfoFile}
+	}
+	return func(ctx context.Context) (string, error) {
+		for _, file := range files {
+			if id, err := scanContainerID(file); err == nil && id != "" {
+				return "container:" + id, nil
+			}
+		}
+		return "", errors.Errorf("container id not found in %v", files)
+	}
+}
+
+func scanContainerID(file string) (string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if id := containerIDPattern.FindString(scanner.Text()); id != "" {
+			return id, nil
+		}
+	}
+	return "", scanner.Err()
+}
+
+func fetchMetadata(ctx context.Context, method, url, header, value string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set(header, value)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("metadata %s returned %s", url, resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if err != nil {
+		return "", err
+	}
+	id := strings.TrimSpace(string(body))
+	if id == "" {
+		return "", errors.Errorf("metadata %s returned empty value", url)
+	}
+	return id, nil
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeSources(t *testing.T) {
+
+	node := uuid.HashNode("k8s:0f1e2d3c")
+	assert.Equal(t, node, uuid.HashNode("k8s:0f1e2d3c"))
+	assert.NotEqual(t, node, uuid.HashNode("k8s:0f1e2d3d"))
+	assert.Equal(t, int64(0x010000000000), node&0x010000000000)
+	assert.Equal(t, int64(0), node>>48)
+
+	ctx := context.Background()
+
+	os.Setenv("POD_UID", "0f1e2d3c")
+	defer os.Unsetenv("POD_UID")
+	node, err := uuid.NodeFrom(ctx, uuid.NodeFromEnv("NOT_SET_NODE"), uuid.NodeFromKubernetes())
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.HashNode("k8s:0f1e2d3c"), node)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/latest/api/token":
+			w.Write([]byte("token"))
+		case "/latest/meta-data/instance-id":
+			if r.Header.Get("X-aws-ec2-metadata-token") != "token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Write([]byte("i-0123456789abcdef0"))
+		case "/computeMetadata/v1/instance/id":
+			if r.Header.Get("Metadata-Flavor") != "Google" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			w.Write([]byte("4520031799277581759\n"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	id, err := uuid.NodeFromEC2(server.URL)(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "ec2:i-0123456789abcdef0", id)
+
+	id, err = uuid.NodeFromGCE(server.URL)(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "gce:4520031799277581759", id)
+
+	dir, err := os.MkdirTemp("", "uuid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cgroup := filepath.Join(dir, "cgroup")
+	containerID := "3f4e2a1b0c9d8e7f6a5b4c3d2e1f0a9b8c7d6e5f4a3b2c1d0e9f8a7b6c5d4e3f"
+	os.WriteFile(cgroup, []byte("12:pids:/docker/"+containerID+"\n"), 0644)
+
+	id, err = uuid.NodeFromContainer(cgroup)(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "container:"+containerID, id)
+
+	_, err = uuid.NodeFrom(ctx, uuid.NodeFromContainer(filepath.Join(dir, "missing")))
+	assert.Error(t, err)
+
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"encoding/binary"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+var ErrorUnorderedUUID = errors.New("UUID has no defined ordering")
+
+/**
+	Stores UUID in to 16 bytes ordered the same way as the UUIDs of its version
+
+    Works for all versions, storage code does not need to branch on version:
+
+    version 1: timestamp reordered like in version 6 keeping the version nibble, counter converted to unsigned bytes
+    others:    big-endian bytes as is, so version 6 and 7 are ordered by time
+
+    Use UnmarshalOrderedBinary to read it back, the layout is detected by the version nibble
+ */
+
+func (this UUID) MarshalOrderedBinary() ([]byte, error) {
+	dst := make([]byte, 16)
+	err := this.MarshalOrderedBinaryTo(dst)
+	return dst, err
+}
+
+/**
+	Same as MarshalOrderedBinary, but fails with ErrorUnorderedUUID for non-IETF variants and unknown versions
+ */
+
+func (this UUID) MarshalOrderedBinaryStrict() ([]byte, error) {
+	if !this.Variant().Valid() {
+		return nil, ErrorUnorderedUUID
+	}
+	switch this.Version() {
+	case BadVersion, UnknownVersion:
+		return nil, ErrorUnorderedUUID
+	}
+	return this.MarshalOrderedBinary()
+}
+
+/**
+	Stores UUID in to the slice in the ordered binary layout
+ */
+
+func (this UUID) MarshalOrderedBinaryTo(dst []byte) error {
+
+	if len(dst) < 16 {
+		return ErrorWrongLen
+	}
+
+	if this.Version() != TimebasedVer1 {
+		return this.MarshalBinaryTo(dst)
+	}
+
+	time100Nanos := this.Time100NanosUnsigned()
+
+	binary.BigEndian.PutUint64(dst, (time100Nanos<<4)&0xFFFFFFFFFFFF0000|timebasedVersionBits|time100Nanos&0x0FFF)
+	binary.BigEndian.PutUint64(dst[8:], this.LeastSigBits^flipSignedBits)
+	return nil
+}
+
+/**
+	Convert the ordered binary layout to UUID
+ */
+
+func (this *UUID) UnmarshalOrderedBinary(data []byte) error {
+
+	if len(data) < 16 {
+		return ErrorWrongLen
+	}
+
+	if data[6]>>4 != byte(TimebasedVer1) {
+		return this.UnmarshalBinary(data)
+	}
+
+	msb := binary.BigEndian.Uint64(data)
+	this.SetTime100NanosUnsigned((msb>>4)&0x0FFFFFFFFFFFF000 | msb&0x0FFF)
+	this.LeastSigBits = binary.BigEndian.Uint64(data[8:]) ^ flipSignedBits
+	return nil
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderedBinary(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	for _, version := range []uuid.Version{uuid.TimebasedVer1, uuid.RandomlyGeneratedVer4, uuid.TimebasedVer7} {
+
+		gen, err := uuid.NewGenerator(version)
+		assert.NoError(t, err)
+
+		var prev []byte
+		for i := 0; i < 1000; i++ {
+
+			id, _ := gen.Next()
+			data, err := id.MarshalOrderedBinary()
+			assert.NoError(t, err)
+
+			var actual uuid.UUID
+			assert.NoError(t, actual.UnmarshalOrderedBinary(data))
+			assert.True(t, id.Equal(actual), version.String())
+
+			if version != uuid.RandomlyGeneratedVer4 && prev != nil {
+				assert.True(t, bytes.Compare(prev, data) < 0, version.String())
+			}
+			prev = data
+		}
+	}
+
+	// counters of version 1 are ordered within the same timestamp
+	id := uuid.New(uuid.TimebasedVer1)
+	id.SetUnixTimeMillis(12345)
+	id.SetMinCounter()
+	min, _ := id.MarshalOrderedBinary()
+	id.SetCounter(1)
+	one, _ := id.MarshalOrderedBinary()
+	id.SetCounter(2)
+	two, _ := id.MarshalOrderedBinary()
+	id.SetMaxCounter()
+	max, _ := id.MarshalOrderedBinary()
+	assert.True(t, bytes.Compare(min, one) < 0)
+	assert.True(t, bytes.Compare(one, two) < 0)
+	assert.True(t, bytes.Compare(two, max) < 0)
+
+	// maximum timestamp
+	id.SetMaxTime()
+	data, _ := id.MarshalOrderedBinary()
+	var actual uuid.UUID
+	assert.NoError(t, actual.UnmarshalOrderedBinary(data))
+	assert.True(t, id.Equal(actual))
+
+	_, err := uuid.Empty.MarshalOrderedBinaryStrict()
+	assert.Equal(t, uuid.ErrorUnorderedUUID, err)
+
+	random, _ := uuid.RandomUUID()
+	_, err = random.MarshalOrderedBinaryStrict()
+	assert.NoError(t, err)
+
+	_, err = uuid.Empty.MarshalOrderedBinary()
+	assert.NoError(t, err)
+
+}
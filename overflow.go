@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+var ErrorCounterOverflow = errors.New("counter overflow within one clock tick")
+
+/**
+	Behavior of the time-based generator when the clock tick is exhausted
+
+    Version 1 has no counter, every UUID needs its own 100-nanos tick,
+    version 7 has 4096 values of the counter within one millisecond,
+    clock sequence of version 1 wraps after 16384 increments by ClockRegressionIncrementSequence.
+ */
+
+type OverflowPolicy int
+
+const (
+
+	/**
+		Borrows future timestamps, default
+	 */
+
+	OverflowBorrow OverflowPolicy = iota
+
+	/**
+		Spins until the clock moves to the next tick, fails if the wait exceeds the max clock wait
+	 */
+
+	OverflowSpin
+
+	/**
+		Returns ErrorCounterOverflow
+	 */
+
+	OverflowFail
+)
+
+var overflowPolicyNames = []string{"borrow", "spin", "fail"}
+
+func (p OverflowPolicy) String() string {
+	if p >= 0 && int(p) < len(overflowPolicyNames) {
+		return overflowPolicyNames[p]
+	}
+	return fmt.Sprintf("OverflowPolicy(%d)", int(p))
+}
+
+/**
+	Parses policy name: borrow, spin or fail
+ */
+
+func ParseOverflowPolicy(s string) (OverflowPolicy, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return OverflowBorrow, nil
+	}
+	for i, name := range overflowPolicyNames {
+		if s == name {
+			return OverflowPolicy(i), nil
+		}
+	}
+	return OverflowBorrow, errors.Errorf("unknown overflow policy: %q", s)
+}
+
+/**
+	Sets counter overflow policy, OverflowBorrow by default
+ */
+
+func (this *TimeGenerator) SetOverflowPolicy(policy OverflowPolicy) {
+	this.Lock()
+	defer this.Unlock()
+	this.overflowPolicy = policy
+}
+
+/**
+	Gets counter overflow policy
+ */
+
+func (this *TimeGenerator) OverflowPolicy() OverflowPolicy {
+	this.Lock()
+	defer this.Unlock()
+	return this.overflowPolicy
+}
+
+/**
+	Waits until the clock moves past the last used timestamp
+ */
+
+func (this *TimeGenerator) spin() (int64, error) {
+	deadline := time.Now().Add(this.maxClockWait)
+	for {
+		if clock := this.readClock(); clock > this.lastTime {
+			if clock > this.lastClock {
+				this.lastClock = clock
+			}
+			return clock, nil
+		}
+		if time.Now().After(deadline) {
+			return 0, ErrorCounterOverflow
+		}
+		runtime.Gosched()
+	}
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOverflowPolicy(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	now := time.Now()
+	clock := func() time.Time { return now }
+
+	gen, err := uuid.NewTimeGenerator(uuid.TimebasedVer7)
+	assert.NoError(t, err)
+	gen.SetClock(clock)
+	gen.SetOverflowPolicy(uuid.OverflowFail)
+	assert.Equal(t, uuid.OverflowFail, gen.OverflowPolicy())
+
+	var failed int
+	for i := 0; i < 5000; i++ {
+		if _, err := gen.Next(); err == uuid.ErrorCounterOverflow {
+			failed++
+		}
+	}
+	assert.True(t, failed > 0)
+
+	now = now.Add(time.Millisecond)
+	id, err := gen.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, now.UnixNano()/int64(time.Millisecond), id.UnixTimeMillis())
+
+	gen, err = uuid.NewTimeGenerator(uuid.TimebasedVer1)
+	assert.NoError(t, err)
+	gen.SetClock(clock)
+	gen.SetOverflowPolicy(uuid.OverflowFail)
+	_, err = gen.Next()
+	assert.NoError(t, err)
+	_, err = gen.Next()
+	assert.Equal(t, uuid.ErrorCounterOverflow, err)
+
+	reads := 0
+	gen.SetClock(func() time.Time {
+		reads++
+		return now.Add(time.Duration(reads/3) * time.Microsecond)
+	})
+	gen.SetOverflowPolicy(uuid.OverflowSpin)
+	prev, err := gen.Next()
+	assert.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		id, err := gen.Next()
+		assert.NoError(t, err)
+		assert.True(t, id.Time100Nanos() > prev.Time100Nanos())
+		assert.Equal(t, int64(0), (id.UnixTime100Nanos()-now.UnixNano()/100)%10)
+		prev = id
+	}
+
+	gen.SetClock(clock)
+	gen.SetMaxClockWait(time.Millisecond)
+	now = now.Add(time.Second)
+	_, err = gen.Next()
+	assert.NoError(t, err)
+	_, err = gen.Next()
+	assert.Equal(t, uuid.ErrorCounterOverflow, err)
+
+	gen.SetClockRegressionPolicy(uuid.ClockRegressionIncrementSequence)
+	gen.SetOverflowPolicy(uuid.OverflowFail)
+	gen.SetClockSequence(5)
+	var wrapped bool
+	for i := 0; i < 0x4000 && !wrapped; i++ {
+		now = now.Add(-time.Microsecond)
+		_, err = gen.Next()
+		wrapped = err == uuid.ErrorCounterOverflow
+	}
+	assert.True(t, wrapped)
+
+	p, err := uuid.ParseOverflowPolicy("spin")
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.OverflowSpin, p)
+	assert.Equal(t, "borrow", uuid.OverflowBorrow.String())
+
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"runtime"
+	"sync"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Number of UUIDs per entropy read of the parallel generation worker
+ */
+
+const parallelBatch = 1024
+
+/**
+	Generates n version 4 UUIDs sharded across the workers, e.g. bulk provisioning of tens of millions of IDs
+
+    Each worker fills its contiguous range of the result from its own entropy buffer,
+    so the result does not depend on scheduling. UUIDs in the reserved ranges are re-rolled.
+    Zero workers means GOMAXPROCS.
+    Fails on the first entropy error, on the first error of Hooks.OnMint or when the context is done.
+ */
+
+func GenerateParallel(ctx context.Context, n, workers int) ([]UUID, error) {
+
+	if err := checkFrozen(); err != nil {
+		return nil, err
+	}
+
+	if n < 0 {
+		return nil, errors.Errorf("negative count %d", n)
+	}
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > n {
+		workers = n
+	}
+
+	ids := make([]UUID, n)
+	if n == 0 {
+		return ids, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var once sync.Once
+	var failure error
+
+	for w := 0; w < workers; w++ {
+		shard := ids[w*n/workers : (w+1)*n/workers]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fillRandom(ctx, shard); err != nil {
+				once.Do(func() {
+					failure = err
+					cancel()
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	if failure != nil {
+		return nil, failure
+	}
+	return ids, nil
+}
+
+func fillRandom(ctx context.Context, shard []UUID) error {
+
+	h := currentHooks()
+	reserved := GetReservedRanges()
+	buf := make([]byte, 16*parallelBatch)
+	rejected := 0
+
+	for len(shard) > 0 {
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		batch := len(shard)
+		if batch > parallelBatch {
+			batch = parallelBatch
+		}
+		if _, err := io.ReadFull(rand.Reader, buf[:16*batch]); err != nil {
+			h.entropyError(err)
+			return errors.Wrap(err, "read entropy")
+		}
+
+		accepted := 0
+		for i := 0; i < batch; i++ {
+			b := buf[16*i:]
+			id := UUID{
+				MostSigBits:  (binary.BigEndian.Uint64(b) &^ versionMask) | uint64(RandomlyGeneratedVer4)<<12,
+				LeastSigBits: (binary.BigEndian.Uint64(b[8:]) & counterMask) | variantIETFBits,
+			}
+			if reserved.Contains(id) {
+				continue
+			}
+			if err := h.generated(id); err != nil {
+				return err
+			}
+			shard[accepted] = id
+			accepted++
+		}
+		if accepted == 0 {
+			if rejected++; rejected == DefaultMaxRerolls {
+				return errors.Wrap(ErrorRejected, "reserved range")
+			}
+		}
+		shard = shard[accepted:]
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateParallel(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	ids, err := uuid.GenerateParallel(context.Background(), 10007, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, 10007, len(ids))
+
+	seen := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		assert.Equal(t, uuid.RandomlyGeneratedVer4, id.Version())
+		assert.Equal(t, uuid.IETF, id.Variant())
+		assert.False(t, seen[id])
+		seen[id] = true
+	}
+
+	ids, err = uuid.GenerateParallel(context.Background(), 5, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, len(ids))
+
+	ids, err = uuid.GenerateParallel(context.Background(), 0, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(ids))
+
+	_, err = uuid.GenerateParallel(context.Background(), -1, 4)
+	assert.Error(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = uuid.GenerateParallel(ctx, 100, 2)
+	assert.Equal(t, context.Canceled, err)
+}
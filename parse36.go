@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"fmt"
+)
+
+/**
+	Offsets of the hex digit pairs in the canonical form
+ */
+
+var canonicalOffsets = [16]int{0, 2, 4, 6, 9, 11, 14, 16, 19, 21, 24, 26, 28, 30, 32, 34}
+
+/**
+	Parses canonical form from the fixed-width field of binary log formats, hex digits in any case
+
+    Takes the array by value without slicing or length checks, invalid digits are collected
+    into one flag checked at the end, so the hot path has no branches per character
+ */
+
+func ParseCanonicalBytes36(b [36]byte) (UUID, error) {
+
+	var hi, lo uint64
+	var invalid int8
+
+	for i := 0; i < 8; i++ {
+		h, l := hexIndex[b[canonicalOffsets[i]]], hexIndex[b[canonicalOffsets[i]+1]]
+		invalid |= h | l
+		hi = hi<<8 | uint64(h)<<4 | uint64(l)
+	}
+	for i := 8; i < 16; i++ {
+		h, l := hexIndex[b[canonicalOffsets[i]]], hexIndex[b[canonicalOffsets[i]+1]]
+		invalid |= h | l
+		lo = lo<<8 | uint64(h)<<4 | uint64(l)
+	}
+
+	hyphens := (b[8] ^ '-') | (b[13] ^ '-') | (b[18] ^ '-') | (b[23] ^ '-')
+
+	if invalid < 0 || hyphens != 0 {
+		return Empty, fmt.Errorf("invalid UUID format: %q", b[:])
+	}
+	return UUID{MostSigBits: hi, LeastSigBits: lo}, nil
+}
@@ -0,0 +1,43 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCanonicalBytes36(t *testing.T) {
+
+	var b [36]byte
+
+	for i := 0; i < 100; i++ {
+		id := fixtureID()
+		copy(b[:], id.String())
+		parsed, err := uuid.ParseCanonicalBytes36(b)
+		assert.NoError(t, err)
+		assert.Equal(t, id, parsed)
+	}
+
+	copy(b[:], "534B44A1-9BF1-3D20-B71E-CC4EB77C572F")
+	parsed, err := uuid.ParseCanonicalBytes36(b)
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.MustParse("534b44a1-9bf1-3d20-b71e-cc4eb77c572f"), parsed)
+
+	for _, bad := range []string{
+		"534b44a1-9bf1-3d20-b71e-cc4eb77c572g",
+		"534b44a1-9bf1-3d20-b71e_cc4eb77c572f",
+		"534b44a19-bf1-3d20-b71e-cc4eb77c572f",
+		"534b44a1-9bf1-3d20-b71e-cc4eb77c572\x00",
+		"{34b44a1-9bf1-3d20-b71e-cc4eb77c572f",
+	} {
+		copy(b[:], bad)
+		_, err := uuid.ParseCanonicalBytes36(b)
+		assert.Error(t, err, bad)
+	}
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+const base58MaxLen = 22
+
+/**
+	Parses UUID in any of canonical, quoted, hex32, braced, urn, base64, base64url, base58, ULID
+    and GUID initializer encodings and reports which one was detected, surrounding whitespace is ignored
+
+    Encodings are told apart by the length, except 22 characters valid in both base64url and base58,
+    then the one decoding to the RFC 4122 layout with a known version wins, base64url if both or none do.
+    Base58 is accepted with up to 22 characters only in the form produced by Base58, where every leading zero byte
+    is '1', so Empty and low values parse while short garbage like "xx" is rejected instead of decoding to a tiny value.
+ */
+
+func ParseAny(s string) (UUID, Encoding, error) {
+
+	s = strings.TrimSpace(s)
+
+	if strings.HasPrefix(s, "{") && strings.ContainsAny(s, "xX") {
+		id, err := ParseGUIDInitializer(s)
+		return id, EncodingGUIDInitializer, err
+	}
+
+	switch len(s) {
+	case 36:
+		id, err := Parse(s)
+		return id, EncodingCanonical, err
+	case 38:
+		switch {
+		case s[0] == '{' && s[37] == '}':
+			id, err := Parse(s)
+			return id, EncodingBraced, err
+		case s[0] == '"' && s[37] == '"':
+			id, err := Parse(s)
+			return id, EncodingCanonical, err
+		}
+		return Empty, EncodingBraced, errors.Errorf("invalid braced UUID: %q", s)
+	case 45:
+		id, err := Parse(s)
+		return id, EncodingURN, err
+	case 32:
+		id, err := ParseHex(s)
+		return id, EncodingHex32, err
+	case ulidLen:
+		id, err := ParseULID(s)
+		return id, EncodingULID, err
+	case 24:
+		id, err := ParseBase64(s)
+		return id, EncodingBase64, err
+	}
+
+	if len(s) == 0 || len(s) > base58MaxLen {
+		return Empty, EncodingCanonical, errors.Errorf("unsupported UUID form: %q", s)
+	}
+
+	base58, err58 := ParseBase58(s)
+	if err58 == nil && base58.Base58() != s {
+		base58, err58 = Empty, errors.Errorf("non-canonical base58 UUID: %q", s)
+	}
+	if len(s) < base58MaxLen {
+		return base58, EncodingBase58, err58
+	}
+
+	// strict decoding rejects non-zero trailing bits, that most of base58 strings have
+	b64, err64 := decodeBase64(base64.RawURLEncoding.Strict(), s)
+	switch {
+	case err64 != nil && err58 != nil:
+		return Empty, EncodingBase64URL, errors.Errorf("unsupported UUID form: %q", s)
+	case err58 != nil:
+		return b64, EncodingBase64URL, nil
+	case err64 != nil:
+		return base58, EncodingBase58, nil
+	case !wellFormed(b64) && wellFormed(base58):
+		return base58, EncodingBase58, nil
+	default:
+		return b64, EncodingBase64URL, nil
+	}
+}
+
+/**
+	Returns true for the IETF variant and versions from 1 to 8
+ */
+
+func wellFormed(id UUID) bool {
+	version := id.Version()
+	return id.Variant() == IETF && version > BadVersion && version < UnknownVersion
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAny(t *testing.T) {
+
+	id := uuid.MustParse("534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+
+	for s, expected := range map[string]uuid.Encoding{
+		"534b44a1-9bf1-3d20-b71e-cc4eb77c572f":          uuid.EncodingCanonical,
+		" 534B44A1-9BF1-3D20-B71E-CC4EB77C572F\n":       uuid.EncodingCanonical,
+		"534b44a19bf13d20b71ecc4eb77c572f":              uuid.EncodingHex32,
+		"{534b44a1-9bf1-3d20-b71e-cc4eb77c572f}":        uuid.EncodingBraced,
+		"urn:uuid:534b44a1-9bf1-3d20-b71e-cc4eb77c572f": uuid.EncodingURN,
+		id.Base64URL():                           uuid.EncodingBase64URL,
+		id.Base58():                              uuid.EncodingBase58,
+		id.ULID():                                uuid.EncodingULID,
+		id.Base64():                              uuid.EncodingBase64,
+		`"534b44a1-9bf1-3d20-b71e-cc4eb77c572f"`: uuid.EncodingCanonical,
+		id.GUIDInitializer():                     uuid.EncodingGUIDInitializer,
+	} {
+		parsed, encoding, err := uuid.ParseAny(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, expected, encoding, s)
+		assert.Equal(t, id, parsed, s)
+	}
+
+	// base58 of 21 characters
+	short := uuid.UUID{MostSigBits: 0x0100000000000000, LeastSigBits: 1}
+	assert.Equal(t, 21, len(short.Base58()))
+	parsed, encoding, err := uuid.ParseAny(short.Base58())
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.EncodingBase58, encoding)
+	assert.Equal(t, short, parsed)
+
+	// base58 is variable-length, low values have leading '1' for every zero byte
+	for _, id := range []uuid.UUID{uuid.Empty, uuid.Max, {LeastSigBits: 1}, {LeastSigBits: 58}, {MostSigBits: 1},
+		{LeastSigBits: 0xFFFFFFFFFFFFFFFF}, {MostSigBits: 0x0000FFFFFFFFFFFF, LeastSigBits: 0xFFFFFFFFFFFFFFFF}} {
+		parsed, encoding, err := uuid.ParseAny(id.Base58())
+		assert.NoError(t, err, id.Base58())
+		assert.Equal(t, uuid.EncodingBase58, encoding, id.Base58())
+		assert.Equal(t, id, parsed, id.Base58())
+	}
+	for i := 0; i < 1000; i++ {
+		id := fixtureID()
+		// drop the high bits to cover all the lengths
+		id.MostSigBits >>= uint(i % 64)
+		if i%128 >= 64 {
+			id.MostSigBits, id.LeastSigBits = 0, id.LeastSigBits>>uint(i%64)
+		}
+		parsed, encoding, err := uuid.ParseAny(id.Base58())
+		assert.NoError(t, err, id.Base58())
+		if len(id.Base58()) < 22 {
+			assert.Equal(t, uuid.EncodingBase58, encoding, id.Base58())
+			assert.Equal(t, id, parsed, id.Base58())
+		}
+	}
+
+	// 22 characters in both alphabets are resolved by the layout
+	misdetected := 0
+	for i := 0; i < 1000; i++ {
+		id := fixtureID()
+		for _, s := range []string{id.Base58(), id.Base64URL()} {
+			if parsed, _, err := uuid.ParseAny(s); err != nil || parsed != id {
+				misdetected++
+			}
+		}
+	}
+	assert.True(t, misdetected < 20, "misdetected %d", misdetected)
+
+	for _, bad := range []string{"", "not-a-uuid", "(534b44a1-9bf1-3d20-b71e-cc4eb77c572f)", strings.Repeat("0", 23), "0OIl",
+		"xx", "2", "abc", "hello", "11111111111111111111", strings.Repeat("z", 22), strings.Repeat("0", 21),
+		"1112", "21111111111111111"} {
+		_, _, err := uuid.ParseAny(bad)
+		assert.Error(t, err, bad)
+	}
+
+	e, err := uuid.ParseEncoding("Base64URL")
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.EncodingBase64URL, e)
+	assert.Equal(t, "ulid", uuid.EncodingULID.String())
+	_, err = uuid.ParseEncoding("base32")
+	assert.Error(t, err)
+}
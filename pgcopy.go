@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"encoding/binary"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Postgres binary COPY field format of uuid column
+
+	field: 32-bit signed big-endian length + value bytes, NULL is length -1
+ */
+
+const (
+	pgCopyFieldLen = 4 + 16
+	pgCopyNullLen  = -1
+)
+
+/**
+	Appends uuid field of the Postgres binary COPY format to the buffer
+ */
+
+func (this UUID) AppendPgCopyBinary(dst []byte) []byte {
+	var field [pgCopyFieldLen]byte
+	binary.BigEndian.PutUint32(field[:], 16)
+	this.MarshalBinaryTo(field[4:])
+	return append(dst, field[:]...)
+}
+
+/**
+	Appends NULL field of the Postgres binary COPY format to the buffer
+ */
+
+func AppendPgCopyBinaryNull(dst []byte) []byte {
+	return append(dst, 0xFF, 0xFF, 0xFF, 0xFF)
+}
+
+/**
+	Decodes uuid field of the Postgres binary COPY format
+
+    Returns the UUID, null flag and the number of consumed bytes
+ */
+
+func DecodePgCopyBinary(data []byte) (id UUID, null bool, n int, err error) {
+
+	if len(data) < 4 {
+		return Empty, false, 0, ErrorWrongLen
+	}
+
+	switch length := int32(binary.BigEndian.Uint32(data)); length {
+	case pgCopyNullLen:
+		return Empty, true, 4, nil
+	case 16:
+		if len(data) < pgCopyFieldLen {
+			return Empty, false, 0, ErrorWrongLen
+		}
+		err = id.UnmarshalBinary(data[4:pgCopyFieldLen])
+		return id, false, pgCopyFieldLen, err
+	default:
+		return Empty, false, 0, errors.Errorf("invalid uuid field length %d in binary COPY", length)
+	}
+}
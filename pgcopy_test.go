@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPgCopyBinary(t *testing.T) {
+
+	id, _ := uuid.Parse("534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+
+	buf := id.AppendPgCopyBinary(nil)
+	buf = uuid.AppendPgCopyBinaryNull(buf)
+	assert.Equal(t, []byte{0, 0, 0, 16, 0x53, 0x4b, 0x44, 0xa1}, buf[:8])
+	assert.Equal(t, []byte{0xff, 0xff, 0xff, 0xff}, buf[20:])
+
+	actual, null, n, err := uuid.DecodePgCopyBinary(buf)
+	assert.NoError(t, err)
+	assert.False(t, null)
+	assert.Equal(t, 20, n)
+	assert.True(t, id.Equal(actual))
+
+	_, null, n, err = uuid.DecodePgCopyBinary(buf[n:])
+	assert.NoError(t, err)
+	assert.True(t, null)
+	assert.Equal(t, 4, n)
+
+	_, _, _, err = uuid.DecodePgCopyBinary(buf[:10])
+	assert.Equal(t, uuid.ErrorWrongLen, err)
+
+	_, _, _, err = uuid.DecodePgCopyBinary([]byte{0, 0, 0, 8, 1, 2, 3, 4, 5, 6, 7, 8})
+	assert.Error(t, err)
+
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"crypto/rand"
+	"sync"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+var ErrorUnsupportedFeature = errors.New("feature is not supported on this platform")
+
+/**
+	Capabilities of the target, degraded under GOOS=js, WASI and TinyGo
+
+    Node "mac" of GeneratorConfig fails with ErrorUnsupportedFeature without HardwareNode,
+    use "random", "env" or the explicit node instead. Entropy "auto" fails without CryptoEntropy,
+    "clock" opts in to math/rand seeded from the clock.
+ */
+
+type Features struct {
+
+	/**
+		Node can be taken from the hardware address of the network interface
+	 */
+
+	HardwareNode bool
+
+	/**
+		crypto/rand returns random bits, e.g. crypto.getRandomValues in browsers
+	 */
+
+	CryptoEntropy bool
+}
+
+var (
+	platformOnce     sync.Once
+	platformFeatures Features
+)
+
+/**
+	Gets capabilities of the target, crypto/rand is probed once on the first call
+ */
+
+func PlatformFeatures() Features {
+	platformOnce.Do(func() {
+		var probe [1]byte
+		_, err := rand.Read(probe[:])
+		platformFeatures = Features{
+			HardwareNode:  hardwareNodeSupported,
+			CryptoEntropy: err == nil,
+		}
+	})
+	return platformFeatures
+}
@@ -0,0 +1,41 @@
+//go:build !js && !wasip1 && !tinygo
+// +build !js,!wasip1,!tinygo
+
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"net"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+const hardwareNodeSupported = true
+
+/**
+	Gets node from the hardware address of the first non-loopback network interface
+ */
+
+func macNode() (int64, error) {
+	interfaces, err := net.Interfaces()
+	if err != nil {
+		return 0, errors.Wrap(err, "list network interfaces")
+	}
+	for _, i := range interfaces {
+		if i.Flags&net.FlagLoopback != 0 || len(i.HardwareAddr) != 6 {
+			continue
+		}
+		var node int64
+		for _, b := range i.HardwareAddr {
+			node = node<<8 | int64(b)
+		}
+		if node != 0 {
+			return node, nil
+		}
+	}
+	return 0, errors.New("no network interface with hardware address")
+}
@@ -0,0 +1,23 @@
+//go:build js || wasip1 || tinygo
+// +build js wasip1 tinygo
+
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+const hardwareNodeSupported = false
+
+/**
+	Network interfaces are not available in browsers, WASI and TinyGo targets
+ */
+
+func macNode() (int64, error) {
+	return 0, errors.Wrap(ErrorUnsupportedFeature, "hardware address")
+}
@@ -0,0 +1,31 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlatformFeatures(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	features := uuid.PlatformFeatures()
+	assert.True(t, features.HardwareNode)
+	assert.True(t, features.CryptoEntropy)
+	assert.Equal(t, features, uuid.PlatformFeatures())
+
+	g, err := uuid.NewGeneratorFromConfig(uuid.GeneratorConfig{Version: 4, Entropy: uuid.EntropyAuto})
+	assert.NoError(t, err)
+	id, err := g.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.RandomlyGeneratedVer4, id.Version())
+}
@@ -0,0 +1,177 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Precision of version 7 timestamp, RFC 9562 section 6.2 method 3
+
+	PrecisionMillis: rand_a is the counter within the millisecond, default
+	PrecisionMicros: rand_a is 12-bit fraction of the millisecond, about 244 nanos
+	PrecisionNanos:  rand_a and 8 leftmost bits of rand_b are 20-bit fraction of the millisecond, about 1 nano
+ */
+
+type TimestampPrecision int
+
+const (
+	PrecisionMillis TimestampPrecision = iota
+	PrecisionMicros
+	PrecisionNanos
+)
+
+var timestampPrecisionNames = []string{"millis", "micros", "nanos"}
+
+func (p TimestampPrecision) String() string {
+	if p >= 0 && int(p) < len(timestampPrecisionNames) {
+		return timestampPrecisionNames[p]
+	}
+	return fmt.Sprintf("TimestampPrecision(%d)", int(p))
+}
+
+/**
+	Parses precision name: millis, micros or nanos
+ */
+
+func ParseTimestampPrecision(s string) (TimestampPrecision, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return PrecisionMillis, nil
+	}
+	for i, name := range timestampPrecisionNames {
+		if s == name {
+			return TimestampPrecision(i), nil
+		}
+	}
+	return PrecisionMillis, errors.Errorf("unknown timestamp precision: %q", s)
+}
+
+func (p TimestampPrecision) fractionBits() uint {
+	switch p {
+	case PrecisionMicros:
+		return 12
+	case PrecisionNanos:
+		return 20
+	default:
+		return 0
+	}
+}
+
+/**
+	Sets precision of version 7 timestamps, must be called before the first UUID is generated
+ */
+
+func (this *TimeGenerator) SetPrecision(precision TimestampPrecision) error {
+	this.Lock()
+	defer this.Unlock()
+	if this.version != TimebasedVer7 {
+		return errors.Errorf("timestamp precision is supported only by version 7, got %v", this.version)
+	}
+	if precision < PrecisionMillis || precision > PrecisionNanos {
+		return errors.Errorf("unknown timestamp precision: %d", precision)
+	}
+	if this.lastTime != 0 {
+		return errors.New("timestamp precision can not be changed after the first UUID")
+	}
+	this.precision = precision
+	return nil
+}
+
+/**
+	Gets precision of version 7 timestamps
+ */
+
+func (this *TimeGenerator) Precision() TimestampPrecision {
+	this.Lock()
+	defer this.Unlock()
+	return this.precision
+}
+
+/**
+	Converts time to unix millis followed by the fraction of millisecond
+ */
+
+func precisionTimestamp(t time.Time, bits uint) int64 {
+	nanos := t.UnixNano()
+	millis := nanos / int64(time.Millisecond)
+	fraction := ((nanos - millis*int64(time.Millisecond)) << bits) / int64(time.Millisecond)
+	return millis<<bits | fraction
+}
+
+func (this *TimeGenerator) nextV7Precise() (uuid UUID, err error) {
+
+	h := currentHooks()
+	bits := this.precision.fractionBits()
+
+	var randomBytes [8]byte
+	if _, err := io.ReadFull(this.reader, randomBytes[:]); err != nil {
+		h.entropyError(err)
+		return Empty, errors.Wrap(err, "read entropy")
+	}
+
+	timestamp, err := this.tick(h)
+	if err != nil {
+		return Empty, err
+	}
+
+	if timestamp <= this.lastTime {
+		h.counterOverflow(this.version)
+		switch this.overflowPolicy {
+		case OverflowSpin:
+			if timestamp, err = this.spin(); err != nil {
+				return Empty, err
+			}
+		case OverflowFail:
+			return Empty, ErrorCounterOverflow
+		default:
+			timestamp = this.lastTime + 1
+		}
+	}
+	this.lastTime = timestamp
+
+	random := binary.BigEndian.Uint64(randomBytes[:]) & counterMask &^ backfillBit
+
+	// align fraction to the 12-bit rand_a followed by the leftmost bits of rand_b
+	fraction := (uint64(timestamp) & (1<<bits - 1)) << (20 - bits)
+
+	uuid.MostSigBits = (uint64(timestamp>>bits) << 16) | v7VersionBits | (fraction >> 8)
+	uuid.LeastSigBits = variantIETFBits | random
+	if bits > 12 {
+		uuid.LeastSigBits = variantIETFBits | (fraction&0xFF)<<54 | (random>>8)&^backfillBit
+	}
+	if err := h.generated(uuid); err != nil {
+		return Empty, err
+	}
+	return uuid, nil
+}
+
+/**
+	Gets timestamp of version 7 UUID generated with the precision
+ */
+
+func (this UUID) TimeV7(precision TimestampPrecision) time.Time {
+
+	millis := int64(this.MostSigBits >> 16)
+	var fraction int64
+
+	switch precision {
+	case PrecisionMicros:
+		fraction = (int64(this.MostSigBits&v7CounterMask)*int64(time.Millisecond) + 1<<11) >> 12
+	case PrecisionNanos:
+		bits := int64(this.MostSigBits&v7CounterMask)<<8 | int64(this.LeastSigBits>>54)&0xFF
+		fraction = (bits*int64(time.Millisecond) + 1<<19) >> 20
+	}
+
+	return time.Unix(millis/1000, (millis%1000)*int64(time.Millisecond)+fraction)
+}
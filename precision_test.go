@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimestampPrecision(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	now := time.Date(2023, 5, 1, 10, 20, 30, 123456789, time.UTC)
+
+	for _, precision := range []uuid.TimestampPrecision{uuid.PrecisionMicros, uuid.PrecisionNanos} {
+
+		gen, err := uuid.NewTimeGenerator(uuid.TimebasedVer7)
+		assert.NoError(t, err)
+		gen.SetClock(func() time.Time { return now })
+		assert.NoError(t, gen.SetPrecision(precision))
+		assert.Equal(t, precision, gen.Precision())
+
+		id, err := gen.Next()
+		assert.NoError(t, err)
+		assert.Equal(t, uuid.TimebasedVer7, id.Version())
+		assert.Equal(t, uuid.IETF, id.Variant())
+		assert.Equal(t, now.UnixNano()/int64(time.Millisecond), id.UnixTimeMillis())
+
+		diff := id.TimeV7(precision).Sub(now)
+		if diff < 0 {
+			diff = -diff
+		}
+		if precision == uuid.PrecisionMicros {
+			assert.True(t, diff < 250*time.Nanosecond, diff)
+		} else {
+			assert.True(t, diff <= time.Nanosecond, diff)
+		}
+
+		prev, _ := id.MarshalBinary()
+		for i := 0; i < 10000; i++ {
+			id, err = gen.Next()
+			assert.NoError(t, err)
+			bin, _ := id.MarshalBinary()
+			if bytes.Compare(prev, bin) >= 0 {
+				t.Fatal("not monotonic ", id)
+			}
+			prev = bin
+		}
+
+		assert.Error(t, gen.SetPrecision(uuid.PrecisionMillis))
+	}
+
+	gen, err := uuid.NewTimeGenerator(uuid.TimebasedVer1)
+	assert.NoError(t, err)
+	assert.Error(t, gen.SetPrecision(uuid.PrecisionMicros))
+
+	id := uuid.UUID{MostSigBits: 0x017F22E279B07CC3, LeastSigBits: 0x98C4DC0C0C07398F}
+	assert.Equal(t, int64(1645557742000), id.TimeV7(uuid.PrecisionMillis).UnixNano()/int64(time.Millisecond))
+
+}
+
+func TestParseTimestampPrecision(t *testing.T) {
+
+	p, err := uuid.ParseTimestampPrecision("Nanos")
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.PrecisionNanos, p)
+	assert.Equal(t, "micros", uuid.PrecisionMicros.String())
+
+	_, err = uuid.ParseTimestampPrecision("seconds")
+	assert.Error(t, err)
+
+	gen, err := uuid.NewGeneratorFromConfig(uuid.GeneratorConfig{Version: 7, Precision: "micros"})
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.PrecisionMicros, gen.(*uuid.TimeGenerator).Precision())
+
+	_, err = uuid.NewGeneratorFromConfig(uuid.GeneratorConfig{Version: 1, Precision: "micros"})
+	assert.Error(t, err)
+
+}
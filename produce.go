@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"context"
+	"sync"
+)
+
+/**
+	Mints UUIDs in the background goroutine into the channel with the buffer, e.g. for the worker pool
+
+    The goroutine blocks while the buffer is full and exits when the context is done or the generator fails,
+    the channel is closed then. Use ProduceWithError to see why the channel was closed.
+ */
+
+func Produce(ctx context.Context, g Generator, buffer int) <-chan UUID {
+	ch, _ := ProduceWithError(ctx, g, buffer)
+	return ch
+}
+
+/**
+	Same as Produce, the function gets the generator error or the context error once the channel is closed, nil before
+ */
+
+func ProduceWithError(ctx context.Context, g Generator, buffer int) (<-chan UUID, func() error) {
+
+	if buffer < 0 {
+		buffer = 0
+	}
+	ch := make(chan UUID, buffer)
+
+	var mu sync.Mutex
+	var cause error
+
+	go func() {
+		defer close(ch)
+		for {
+			id, err := g.Next()
+			if err == nil {
+				select {
+				case ch <- id:
+					continue
+				case <-ctx.Done():
+					err = ctx.Err()
+				}
+			}
+			mu.Lock()
+			cause = err
+			mu.Unlock()
+			return
+		}
+	}()
+
+	return ch, func() error {
+		mu.Lock()
+		defer mu.Unlock()
+		return cause
+	}
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type countingGenerator struct {
+	sync.Mutex
+	left  int
+	calls int
+}
+
+func (this *countingGenerator) Next() (uuid.UUID, error) {
+	this.Lock()
+	defer this.Unlock()
+	this.calls++
+	if this.left == 0 {
+		return uuid.Empty, uuid.ErrorCounterOverflow
+	}
+	this.left--
+	return uuid.RandomUUID()
+}
+
+func TestProduce(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch, cause := uuid.ProduceWithError(ctx, uuid.NewRandomGenerator(), 4)
+
+	seen := make(map[uuid.UUID]bool)
+	for i := 0; i < 100; i++ {
+		id := <-ch
+		assert.False(t, seen[id])
+		seen[id] = true
+	}
+	cancel()
+	for range ch {
+	}
+	assert.Equal(t, context.Canceled, cause())
+}
+
+func TestProduceBackpressure(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	g := &countingGenerator{left: 1000}
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := uuid.Produce(ctx, g, 2)
+
+	<-ch
+	for len(ch) < 2 {
+	}
+	g.Lock()
+	calls := g.calls
+	g.Unlock()
+	assert.True(t, calls <= 4)
+
+	cancel()
+	for range ch {
+	}
+}
+
+func TestProduceGeneratorError(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	ch, cause := uuid.ProduceWithError(context.Background(), &countingGenerator{left: 3}, 0)
+	n := 0
+	for range ch {
+		n++
+	}
+	assert.Equal(t, 3, n)
+	assert.Equal(t, uuid.ErrorCounterOverflow, cause())
+}
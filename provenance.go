@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"sync"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Layout of version 8 UUID minted by ProvenanceGenerator
+
+	msb: 48-bit unix_ts_ms + 4-bit version + 12-bit counter, same as version 7
+	lsb: 2-bit variant + 12-bit layout marker + 16-bit source tag + 34-bit random
+
+    The marker tells provenance UUIDs apart from the other version 8 layouts, see NewExpiring
+ */
+
+const (
+	v8LayoutProvenance = uint64(0x5A3)
+
+	sourceTagShift = 34
+	sourceTagMask  = uint64(0xFFFF) << sourceTagShift
+)
+
+/**
+	Code of the service and environment that minted the UUID
+ */
+
+type SourceTag uint16
+
+/**
+	Service and environment registered for the source tag
+ */
+
+type Source struct {
+	Service     string
+	Environment string
+}
+
+var (
+	sourceLock sync.RWMutex
+	sources    = make(map[SourceTag]Source)
+)
+
+/**
+	Registers the source of the tag, fails if the tag is taken by another source
+ */
+
+func RegisterSource(tag SourceTag, source Source) error {
+	sourceLock.Lock()
+	defer sourceLock.Unlock()
+	if existing, ok := sources[tag]; ok && existing != source {
+		return errors.Errorf("source tag %d is taken by %s/%s", tag, existing.Service, existing.Environment)
+	}
+	sources[tag] = source
+	return nil
+}
+
+/**
+	Gets registered source of the tag
+ */
+
+func LookupSource(tag SourceTag) (Source, bool) {
+	sourceLock.RLock()
+	defer sourceLock.RUnlock()
+	source, ok := sources[tag]
+	return source, ok
+}
+
+/**
+	Gets source tag of the version 8 UUID minted by ProvenanceGenerator, false for other UUIDs
+
+    Version 8 UUIDs of other layouts are recognized by the layout marker,
+    a foreign version 8 UUID carries the marker with probability 1/4096.
+ */
+
+func SourceOf(id UUID) (SourceTag, bool) {
+	if !hasV8Layout(id, v8LayoutProvenance) {
+		return 0, false
+	}
+	return SourceTag((id.LeastSigBits & sourceTagMask) >> sourceTagShift), true
+}
+
+/**
+	Generator of time-ordered version 8 UUIDs carrying the source tag, so the ID alone tells who minted it
+
+    Timestamp and counter follow the version 7 generator, UUIDs of the same generator are strictly increasing.
+    The layout marker and the tag replace 28 random bits, 34 random bits remain.
+ */
+
+type ProvenanceGenerator struct {
+	tag  SourceTag
+	time *TimeGenerator
+}
+
+/**
+	Creates generator stamping the tag
+ */
+
+func NewProvenanceGenerator(tag SourceTag) (*ProvenanceGenerator, error) {
+	gen, err := NewTimeGenerator(TimebasedVer7)
+	if err != nil {
+		return nil, err
+	}
+	return &ProvenanceGenerator{tag: tag, time: gen}, nil
+}
+
+/**
+	Gets source tag of the generator
+ */
+
+func (this *ProvenanceGenerator) Tag() SourceTag {
+	return this.tag
+}
+
+/**
+	Generates version 8 UUID with the source tag
+
+    Next implements the Generator interface.
+ */
+
+func (this *ProvenanceGenerator) Next() (UUID, error) {
+	return rerollReserved(func() (UUID, error) {
+		id, err := this.time.Next()
+		if err != nil {
+			return Empty, err
+		}
+		id.MostSigBits = (id.MostSigBits &^ versionMask) | v8VersionBits
+		id.LeastSigBits = (id.LeastSigBits &^ (v8LayoutMask | sourceTagMask)) | v8LayoutProvenance<<v8LayoutShift | uint64(this.tag)<<sourceTagShift
+		return id, nil
+	})
+}
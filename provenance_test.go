@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProvenance(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	tag := uuid.SourceTag(0xBEEF)
+	assert.NoError(t, uuid.RegisterSource(tag, uuid.Source{Service: "billing", Environment: "prod"}))
+	assert.NoError(t, uuid.RegisterSource(tag, uuid.Source{Service: "billing", Environment: "prod"}))
+	assert.Error(t, uuid.RegisterSource(tag, uuid.Source{Service: "search", Environment: "prod"}))
+
+	g, err := uuid.NewProvenanceGenerator(tag)
+	assert.NoError(t, err)
+	assert.Equal(t, tag, g.Tag())
+
+	var prev uuid.UUID
+	for i := 0; i < 100; i++ {
+		id, err := g.Next()
+		assert.NoError(t, err)
+		assert.Equal(t, uuid.CustomVer8, id.Version())
+		assert.Equal(t, uuid.IETF, id.Variant())
+		assert.True(t, uuid.ComparePostgres(prev, id) < 0)
+		prev = id
+
+		source, ok := uuid.SourceOf(id)
+		assert.True(t, ok)
+		assert.Equal(t, tag, source)
+	}
+
+	source, ok := uuid.LookupSource(tag)
+	assert.True(t, ok)
+	assert.Equal(t, "billing", source.Service)
+	_, ok = uuid.LookupSource(1)
+	assert.False(t, ok)
+
+	v4, _ := uuid.RandomUUID()
+	_, ok = uuid.SourceOf(v4)
+	assert.False(t, ok)
+
+	_, ok = uuid.SourceOf(uuid.DeriveV8(uuid.NamespaceURL, []byte("no provenance")))
+	assert.False(t, ok)
+
+	expiring, err := uuid.NewExpiring(time.Hour)
+	assert.NoError(t, err)
+	_, ok = uuid.SourceOf(expiring)
+	assert.False(t, ok)
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+	"io"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Hashes the stream into the name-based UUID without buffering the content, e.g. file or blob upload
+
+    Only the content is hashed, version 3 and 5 give the same UUID as NameUUIDFromBytes over the whole content,
+    version 8 hashes the content with SHA-256. Use FromReaderIn for the RFC 4122 namespaced UUIDs.
+ */
+
+func FromReader(r io.Reader, version Version) (UUID, error) {
+	return hashReader(nil, r, version)
+}
+
+/**
+	Hashes the stream in the namespace into the name-based UUID
+
+    Version 3 and 5 give the same UUID as NewNameBased over the whole content,
+    version 8 gives the same UUID as DeriveV8.
+ */
+
+func FromReaderIn(namespace UUID, r io.Reader, version Version) (UUID, error) {
+	var ns [16]byte
+	namespace.MarshalBinaryTo(ns[:])
+	return hashReader(ns[:], r, version)
+}
+
+func hashReader(prefix []byte, r io.Reader, version Version) (UUID, error) {
+
+	var h hash.Hash
+	switch version {
+	case NamebasedVer3:
+		h = md5.New()
+	case NamebasedVer5:
+		h = sha1.New()
+	case CustomVer8:
+		h = sha256.New()
+	default:
+		return Empty, errors.Errorf("unknown namebased version: %q", version)
+	}
+
+	h.Write(prefix)
+	if _, err := io.Copy(h, r); err != nil {
+		return Empty, errors.Wrap(err, "read stream")
+	}
+	sum := h.Sum(nil)
+
+	var uuid UUID
+	uuid.MostSigBits = (binary.BigEndian.Uint64(sum) &^ versionMask) | uint64(version)<<12
+	uuid.LeastSigBits = (binary.BigEndian.Uint64(sum[8:]) & counterMask) | variantIETFBits
+	return uuid, nil
+}
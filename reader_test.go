@@ -0,0 +1,55 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromReader(t *testing.T) {
+
+	content := bytes.Repeat([]byte("0123456789abcdef"), 100000)
+
+	for _, version := range []uuid.Version{uuid.NamebasedVer3, uuid.NamebasedVer5} {
+		id, err := uuid.FromReaderIn(uuid.NamespaceURL, iotest.HalfReader(bytes.NewReader(content)), version)
+		assert.NoError(t, err)
+		expected, err := uuid.NewNameBased(uuid.NamespaceURL, content, version)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, id)
+		assert.Equal(t, version, id.Version())
+	}
+
+	for _, version := range []uuid.Version{uuid.NamebasedVer3, uuid.NamebasedVer5} {
+		id, err := uuid.FromReader(iotest.OneByteReader(bytes.NewReader(content[:1000])), version)
+		assert.NoError(t, err)
+		expected, err := uuid.NameUUIDFromBytes(content[:1000], version)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, id)
+	}
+
+	id, err := uuid.FromReaderIn(uuid.Empty, bytes.NewReader(content), uuid.CustomVer8)
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.DeriveV8(uuid.Empty, content), id)
+
+	id, err = uuid.FromReader(bytes.NewReader(content), uuid.CustomVer8)
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.CustomVer8, id.Version())
+	assert.NotEqual(t, uuid.DeriveV8(uuid.Empty, content), id)
+
+	_, err = uuid.FromReader(bytes.NewReader(content), uuid.RandomlyGeneratedVer4)
+	assert.Error(t, err)
+
+	fail := errors.New("disk error")
+	_, err = uuid.FromReader(io.MultiReader(bytes.NewReader(content), iotest.ErrReader(fail)), uuid.NamebasedVer5)
+	assert.True(t, errors.Is(err, fail))
+}
@@ -0,0 +1,213 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Number of bloom filters in the ring of RecentWindow, one is being filled and the rest cover the window
+ */
+
+const recentSlots = 5
+
+/**
+	Remembers UUIDs seen during the last window with bounded memory and no central cache
+
+    Guards consumers of at-least-once deliveries keyed by UUID against duplicates.
+    IDs are kept in a ring of bloom filters rotated every window/4, so an ID is remembered
+    at least for the window and at most for 5/4 of it. False positives are possible with
+    the configured rate, false negatives within the window are not.
+ */
+
+type RecentWindow struct {
+	sync.Mutex
+
+	slot    time.Duration
+	filters [recentSlots]bloomFilter
+	current int
+	rotated time.Time
+
+	now func() time.Time
+}
+
+/**
+	Creates window remembering UUIDs for the duration, sized for at most capacity UUIDs per window
+    and the false positive rate in range (0, 1)
+ */
+
+func NewRecentWindow(window time.Duration, capacity int, falsePositiveRate float64) (*RecentWindow, error) {
+
+	if window <= 0 {
+		return nil, errors.Errorf("window %v must be positive", window)
+	}
+	if capacity <= 0 {
+		return nil, errors.Errorf("capacity %d must be positive", capacity)
+	}
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		return nil, errors.Errorf("false positive rate %v is out of range (0, 1)", falsePositiveRate)
+	}
+
+	// every lookup checks all filters, so they share the rate
+	rate := falsePositiveRate / recentSlots
+	bits := int(math.Ceil(-float64(capacity) * math.Log(rate) / (math.Ln2 * math.Ln2)))
+	hashes := int(math.Round(float64(bits) / float64(capacity) * math.Ln2))
+	if hashes < 1 {
+		hashes = 1
+	}
+
+	w := &RecentWindow{
+		slot: window / (recentSlots - 1),
+		now:  time.Now,
+	}
+	if w.slot <= 0 {
+		w.slot = 1
+	}
+	for i := range w.filters {
+		w.filters[i] = newBloomFilter(bits, hashes)
+	}
+	return w, nil
+}
+
+/**
+	Sets source of the wall clock, time.Now by default, used in tests and simulations
+ */
+
+func (this *RecentWindow) SetClock(now func() time.Time) {
+	this.Lock()
+	defer this.Unlock()
+	this.now = now
+}
+
+/**
+	Returns true if the UUID was added during the window, the false positive rate applies
+ */
+
+func (this *RecentWindow) SeenRecently(id UUID) bool {
+	this.Lock()
+	defer this.Unlock()
+	this.rotate()
+	return this.contains(id)
+}
+
+/**
+	Remembers the UUID
+ */
+
+func (this *RecentWindow) Add(id UUID) {
+	this.Lock()
+	defer this.Unlock()
+	this.rotate()
+	this.filters[this.current].add(id)
+}
+
+/**
+	Remembers the UUID and returns true if it was already seen during the window, atomically
+ */
+
+func (this *RecentWindow) Observe(id UUID) bool {
+	this.Lock()
+	defer this.Unlock()
+	this.rotate()
+	if this.contains(id) {
+		return true
+	}
+	this.filters[this.current].add(id)
+	return false
+}
+
+func (this *RecentWindow) contains(id UUID) bool {
+	for i := range this.filters {
+		if this.filters[i].contains(id) {
+			return true
+		}
+	}
+	return false
+}
+
+func (this *RecentWindow) rotate() {
+
+	now := this.now()
+	if this.rotated.IsZero() {
+		this.rotated = now
+		return
+	}
+
+	elapsed := now.Sub(this.rotated)
+	if elapsed < this.slot {
+		return
+	}
+
+	steps := int64(elapsed / this.slot)
+	this.rotated = this.rotated.Add(time.Duration(steps) * this.slot)
+	if steps > recentSlots {
+		steps = recentSlots
+	}
+	for ; steps > 0; steps-- {
+		this.current = (this.current + 1) % recentSlots
+		this.filters[this.current].reset()
+	}
+}
+
+type bloomFilter struct {
+	words  []uint64
+	bits   uint64
+	hashes int
+}
+
+func newBloomFilter(bits, hashes int) bloomFilter {
+	words := (bits + 63) / 64
+	return bloomFilter{words: make([]uint64, words), bits: uint64(words * 64), hashes: hashes}
+}
+
+func (this *bloomFilter) add(id UUID) {
+	h1, h2 := bloomHashes(id)
+	for i := 0; i < this.hashes; i++ {
+		bit := (h1 + uint64(i)*h2) % this.bits
+		this.words[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+func (this *bloomFilter) contains(id UUID) bool {
+	h1, h2 := bloomHashes(id)
+	for i := 0; i < this.hashes; i++ {
+		bit := (h1 + uint64(i)*h2) % this.bits
+		if this.words[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *bloomFilter) reset() {
+	for i := range this.words {
+		this.words[i] = 0
+	}
+}
+
+/**
+	Mixes all bits, so time-based UUIDs with mostly equal prefixes are spread over the filter
+ */
+
+func bloomHashes(id UUID) (uint64, uint64) {
+	h1 := mix64(id.MostSigBits ^ mix64(id.LeastSigBits))
+	h2 := mix64(h1^0x9E3779B97F4A7C15) | 1
+	return h1, h2
+}
+
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xBF58476D1CE4E5B9
+	x ^= x >> 27
+	x *= 0x94D049BB133111EB
+	x ^= x >> 31
+	return x
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecentWindow(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	w, err := uuid.NewRecentWindow(time.Minute, 1000, 0.001)
+	assert.NoError(t, err)
+
+	now := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	w.SetClock(func() time.Time { return now })
+
+	first := uuid.New(uuid.RandomlyGeneratedVer4)
+	first.SetCounter(1)
+	assert.False(t, w.Observe(first))
+	assert.True(t, w.Observe(first))
+
+	now = now.Add(59 * time.Second)
+	assert.True(t, w.SeenRecently(first))
+
+	now = now.Add(17 * time.Second)
+	assert.False(t, w.SeenRecently(first))
+
+	gen, err := uuid.NewTimeGenerator(uuid.TimebasedVer7)
+	assert.NoError(t, err)
+	ids := make([]uuid.UUID, 1000)
+	for i := range ids {
+		ids[i], _ = gen.Next()
+		w.Add(ids[i])
+	}
+	for _, id := range ids {
+		assert.True(t, w.SeenRecently(id))
+	}
+
+	falsePositives := 0
+	for i := 0; i < 10000; i++ {
+		id, _ := gen.Next()
+		if w.SeenRecently(id) {
+			falsePositives++
+		}
+	}
+	assert.True(t, falsePositives < 50, "false positives %d", falsePositives)
+
+	now = now.Add(time.Hour)
+	assert.False(t, w.SeenRecently(ids[0]))
+
+	_, err = uuid.NewRecentWindow(0, 1, 0.1)
+	assert.Error(t, err)
+	_, err = uuid.NewRecentWindow(time.Second, 0, 0.1)
+	assert.Error(t, err)
+	_, err = uuid.NewRecentWindow(time.Second, 1, 1)
+	assert.Error(t, err)
+}
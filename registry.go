@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Named generators configured in one place and retrieved throughout the application
+ */
+
+type Registry struct {
+	sync.RWMutex
+	generators map[string]Generator
+}
+
+var defaultRegistry = NewRegistry()
+
+/**
+	Creates empty registry
+ */
+
+func NewRegistry() *Registry {
+	return &Registry{generators: make(map[string]Generator)}
+}
+
+/**
+	Registers generator under the name, fails if the name is taken
+ */
+
+func (this *Registry) Register(name string, gen Generator) error {
+	if gen == nil {
+		return errors.Errorf("nil generator for '%s'", name)
+	}
+	this.Lock()
+	defer this.Unlock()
+	if _, ok := this.generators[name]; ok {
+		return errors.Errorf("generator '%s' is already registered", name)
+	}
+	this.generators[name] = gen
+	return nil
+}
+
+/**
+	Creates and registers generators from the configs by name, nothing is registered on error
+ */
+
+func (this *Registry) RegisterConfigs(configs map[string]GeneratorConfig) error {
+
+	generators := make(map[string]Generator, len(configs))
+	for name, cfg := range configs {
+		gen, err := NewGeneratorFromConfig(cfg)
+		if err != nil {
+			return errors.Wrapf(err, "generator '%s'", name)
+		}
+		generators[name] = gen
+	}
+
+	this.Lock()
+	defer this.Unlock()
+	for name := range generators {
+		if _, ok := this.generators[name]; ok {
+			return errors.Errorf("generator '%s' is already registered", name)
+		}
+	}
+	for name, gen := range generators {
+		this.generators[name] = gen
+	}
+	return nil
+}
+
+/**
+	Gets generator by name
+ */
+
+func (this *Registry) Get(name string) (Generator, bool) {
+	this.RLock()
+	defer this.RUnlock()
+	gen, ok := this.generators[name]
+	return gen, ok
+}
+
+/**
+	Generates UUID by the named generator
+ */
+
+func (this *Registry) Next(name string) (UUID, error) {
+	gen, ok := this.Get(name)
+	if !ok {
+		return Empty, errors.Errorf("generator '%s' is not registered", name)
+	}
+	return gen.Next()
+}
+
+/**
+	Removes generator by name
+ */
+
+func (this *Registry) Unregister(name string) {
+	this.Lock()
+	defer this.Unlock()
+	delete(this.generators, name)
+}
+
+/**
+	Gets sorted names of registered generators
+ */
+
+func (this *Registry) Names() []string {
+	this.RLock()
+	defer this.RUnlock()
+	names := make([]string, 0, len(this.generators))
+	for name := range this.generators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+/**
+	Registers generator in the process-wide registry
+ */
+
+func Register(name string, gen Generator) error {
+	return defaultRegistry.Register(name, gen)
+}
+
+/**
+	Gets generator from the process-wide registry
+ */
+
+func Get(name string) (Generator, bool) {
+	return defaultRegistry.Get(name)
+}
+
+/**
+	Gets process-wide registry
+ */
+
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
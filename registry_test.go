@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	orders, err := uuid.NewTimeGenerator(uuid.TimebasedVer7)
+	assert.NoError(t, err)
+
+	assert.NoError(t, uuid.Register("orders", orders))
+	defer uuid.DefaultRegistry().Unregister("orders")
+	assert.Error(t, uuid.Register("orders", uuid.NewRandomGenerator()))
+	assert.Error(t, uuid.Register("nil", nil))
+
+	gen, ok := uuid.Get("orders")
+	assert.True(t, ok)
+	assert.Equal(t, orders, gen)
+
+	_, ok = uuid.Get("unknown")
+	assert.False(t, ok)
+
+	registry := uuid.NewRegistry()
+	err = registry.RegisterConfigs(map[string]uuid.GeneratorConfig{
+		"orders": {Version: 7, Monotonic: true},
+		"tokens": {Version: 4},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"orders", "tokens"}, registry.Names())
+
+	id, err := registry.Next("tokens")
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.RandomlyGeneratedVer4, id.Version())
+
+	id, err = registry.Next("orders")
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.TimebasedVer7, id.Version())
+
+	_, err = registry.Next("unknown")
+	assert.Error(t, err)
+
+	err = registry.RegisterConfigs(map[string]uuid.GeneratorConfig{"sessions": {Version: 4}, "tokens": {Version: 4}})
+	assert.Error(t, err)
+	_, ok = registry.Get("sessions")
+	assert.False(t, ok)
+
+	assert.Error(t, registry.RegisterConfigs(map[string]uuid.GeneratorConfig{"bad": {Version: 2}}))
+
+}
@@ -0,0 +1,205 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Behavior of the time-based generator when the wall clock moves backwards, e.g. NTP step or VM migration
+ */
+
+type ClockRegressionPolicy int
+
+const (
+
+	/**
+		Continues from the last used timestamp, keeps UUIDs strictly increasing, default
+	 */
+
+	ClockRegressionBorrow ClockRegressionPolicy = iota
+
+	/**
+		Increments clock sequence and uses the wall clock as RFC 4122 section 4.2.1 describes,
+		UUIDs stay unique but are not increasing, version 7 has no clock sequence and borrows,
+		generator of a coordinator Lease increments within its slot and borrows when the slot is exhausted
+	 */
+
+	ClockRegressionIncrementSequence
+
+	/**
+		Sleeps until the wall clock catches up, fails if the regression is larger than the max wait
+	 */
+
+	ClockRegressionWait
+
+	/**
+		Returns *ClockRegressionError
+	 */
+
+	ClockRegressionFail
+)
+
+/**
+	Default max sleep of ClockRegressionWait policy
+ */
+
+const DefaultMaxClockWait = time.Second
+
+var clockRegressionPolicyNames = []string{"borrow", "increment", "wait", "fail"}
+
+func (p ClockRegressionPolicy) String() string {
+	if p >= 0 && int(p) < len(clockRegressionPolicyNames) {
+		return clockRegressionPolicyNames[p]
+	}
+	return fmt.Sprintf("ClockRegressionPolicy(%d)", int(p))
+}
+
+/**
+	Parses policy name: borrow, increment, wait or fail
+ */
+
+func ParseClockRegressionPolicy(s string) (ClockRegressionPolicy, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return ClockRegressionBorrow, nil
+	}
+	for i, name := range clockRegressionPolicyNames {
+		if s == name {
+			return ClockRegressionPolicy(i), nil
+		}
+	}
+	return ClockRegressionBorrow, errors.Errorf("unknown clock regression policy: %q", s)
+}
+
+/**
+	Error returned by time-based generators with ClockRegressionFail or ClockRegressionWait policy
+ */
+
+type ClockRegressionError struct {
+	Version   Version
+	Backwards time.Duration
+}
+
+func (e *ClockRegressionError) Error() string {
+	return fmt.Sprintf("clock moved backwards by %v, %v generator refused to mint", e.Backwards, e.Version)
+}
+
+/**
+	Sets clock regression policy, ClockRegressionBorrow by default
+ */
+
+func (this *TimeGenerator) SetClockRegressionPolicy(policy ClockRegressionPolicy) {
+	this.Lock()
+	defer this.Unlock()
+	this.regressionPolicy = policy
+}
+
+/**
+	Gets clock regression policy
+ */
+
+func (this *TimeGenerator) ClockRegressionPolicy() ClockRegressionPolicy {
+	this.Lock()
+	defer this.Unlock()
+	return this.regressionPolicy
+}
+
+/**
+	Sets max sleep of ClockRegressionWait policy, DefaultMaxClockWait by default
+ */
+
+func (this *TimeGenerator) SetMaxClockWait(d time.Duration) {
+	this.Lock()
+	defer this.Unlock()
+	this.maxClockWait = d
+}
+
+/**
+	Gets wall clock in 100 nanos since UUID epoch for v1 and in unix millis followed by the precision bits for v7
+ */
+
+func (this *TimeGenerator) readClock() int64 {
+	now := this.now()
+	if this.version == TimebasedVer7 {
+		return precisionTimestamp(now, this.precision.fractionBits())
+	}
+	return now.Unix()*one100NanosInSecond + int64(now.Nanosecond()/100) + num100NanosSinceUUIDEpoch
+}
+
+/**
+	Converts difference of clock readings to duration
+ */
+
+func (this *TimeGenerator) clockDuration(delta int64) time.Duration {
+	if this.version == TimebasedVer7 {
+		return (time.Duration(delta) * time.Millisecond) >> this.precision.fractionBits()
+	}
+	return time.Duration(delta) * 100
+}
+
+/**
+	Reads wall clock and applies the clock regression policy
+ */
+
+func (this *TimeGenerator) tick(h *Hooks) (int64, error) {
+
+	clock := this.readClock()
+	if clock >= this.lastClock {
+		this.lastClock = clock
+		return clock, nil
+	}
+
+	backwards := this.clockDuration(this.lastClock - clock)
+	h.clockRegression(this.version, backwards)
+
+	switch this.regressionPolicy {
+
+	case ClockRegressionFail:
+		return 0, &ClockRegressionError{Version: this.version, Backwards: backwards}
+
+	case ClockRegressionWait:
+		if backwards > this.maxClockWait {
+			return 0, &ClockRegressionError{Version: this.version, Backwards: backwards}
+		}
+		time.Sleep(backwards)
+		if clock = this.readClock(); clock < this.lastClock {
+			return 0, &ClockRegressionError{Version: this.version, Backwards: this.clockDuration(this.lastClock - clock)}
+		}
+
+	case ClockRegressionIncrementSequence:
+		if this.version == TimebasedVer1 && this.lease != nil {
+			next := this.lease.advance(this.clockSequence)
+			if next == this.initialClockSequence {
+				h.counterOverflow(this.version)
+				if this.overflowPolicy == OverflowFail {
+					return 0, ErrorCounterOverflow
+				}
+				break
+			}
+			this.clockSequence = next
+			this.lastTime = clock - 1
+		} else if this.version == TimebasedVer1 {
+			next := this.initialClockSequence&backfillSequenceBand | (this.clockSequence+1)&backfillSequenceMask
+			if next == this.initialClockSequence {
+				h.counterOverflow(this.version)
+				if this.overflowPolicy == OverflowFail {
+					return 0, ErrorCounterOverflow
+				}
+			}
+			this.clockSequence = next
+			this.lastTime = clock - 1
+		}
+	}
+
+	this.lastClock = clock
+	return clock, nil
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClockRegressionPolicy(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	now := time.Now()
+	clock := func() time.Time { return now }
+
+	gen, err := uuid.NewTimeGenerator(uuid.TimebasedVer1)
+	assert.NoError(t, err)
+	gen.SetClock(clock)
+	assert.Equal(t, uuid.ClockRegressionBorrow, gen.ClockRegressionPolicy())
+
+	first, _ := gen.Next()
+	now = now.Add(-time.Minute)
+	second, err := gen.Next()
+	assert.NoError(t, err)
+	assert.True(t, second.Time100Nanos() > first.Time100Nanos())
+
+	gen.SetClockRegressionPolicy(uuid.ClockRegressionIncrementSequence)
+	seq := gen.ClockSequence()
+	now = now.Add(-time.Minute)
+	third, err := gen.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, now.UnixNano()/100, third.UnixTime100Nanos())
+	assert.Equal(t, seq&0x2000|(seq+1)&0x1FFF, third.ClockSequence())
+
+	gen.SetClockRegressionPolicy(uuid.ClockRegressionFail)
+	now = now.Add(-time.Second)
+	_, err = gen.Next()
+	var regression *uuid.ClockRegressionError
+	if assert.True(t, errors.As(err, &regression)) {
+		assert.Equal(t, time.Second, regression.Backwards)
+		assert.Equal(t, uuid.TimebasedVer1, regression.Version)
+	}
+
+	gen, err = uuid.NewTimeGenerator(uuid.TimebasedVer7)
+	assert.NoError(t, err)
+	now = time.Now()
+	reads := 0
+	gen.SetClock(func() time.Time {
+		reads++
+		if reads == 2 {
+			return now.Add(-5 * time.Millisecond)
+		}
+		return now.Add(time.Duration(reads) * time.Millisecond)
+	})
+	gen.SetClockRegressionPolicy(uuid.ClockRegressionWait)
+	first, _ = gen.Next()
+	second, err = gen.Next()
+	assert.NoError(t, err)
+	assert.True(t, second.UnixTimeMillis() > first.UnixTimeMillis())
+
+	gen.SetMaxClockWait(time.Millisecond)
+	reads = 1
+	_, err = gen.Next()
+	assert.True(t, errors.As(err, &regression))
+
+	p, err := uuid.ParseClockRegressionPolicy("WAIT")
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.ClockRegressionWait, p)
+	assert.Equal(t, "fail", uuid.ClockRegressionFail.String())
+	_, err = uuid.ParseClockRegressionPolicy("ignore")
+	assert.Error(t, err)
+
+}
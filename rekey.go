@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"time"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Derives version 7 keys from existing keys and creation times for migration of tables to time-ordered keys
+
+    The new key has unix_ts_ms of the creation time and HMAC-SHA256 of the old key in rand_a and rand_b,
+    so the migration is deterministic, can be re-run or resumed, and the mapping can not be reversed without the key
+ */
+
+type Rekeyer struct {
+	key []byte
+}
+
+/**
+	Creates rekeyer with the secret key
+ */
+
+func NewRekeyer(key []byte) (*Rekeyer, error) {
+	if len(key) == 0 {
+		return nil, errors.New("empty rekey key")
+	}
+	return &Rekeyer{key: append([]byte(nil), key...)}, nil
+}
+
+/**
+	Gets version 7 key of the old key created at the time
+ */
+
+func (this *Rekeyer) Rekey(old UUID, createdAt time.Time) (uuid UUID) {
+
+	var data [16]byte
+	old.MarshalBinaryTo(data[:])
+
+	mac := hmac.New(sha256.New, this.key)
+	mac.Write(data[:])
+	digest := mac.Sum(nil)
+
+	millis := uint64(createdAt.UnixNano()/int64(time.Millisecond)) & 0xFFFFFFFFFFFF
+
+	uuid.MostSigBits = (millis << 16) | v7VersionBits | uint64(binary.BigEndian.Uint16(digest[:2]))&v7CounterMask
+	uuid.LeastSigBits = (binary.BigEndian.Uint64(digest[2:10]) & counterMask) | variantIETFBits
+	return uuid
+}
+
+/**
+	Checks that the new key was derived from the old key
+ */
+
+func (this *Rekeyer) Verify(old, new UUID) bool {
+	if new.Version() != TimebasedVer7 {
+		return false
+	}
+	millis := new.UnixTimeMillis()
+	expected := this.Rekey(old, time.Unix(millis/1000, (millis%1000)*int64(time.Millisecond)))
+	return expected.Equal(new)
+}
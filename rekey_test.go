@@ -0,0 +1,42 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRekeyer(t *testing.T) {
+
+	rekeyer, err := uuid.NewRekeyer([]byte("migration-secret"))
+	assert.NoError(t, err)
+
+	old, err := uuid.Parse("534b44a1-9bf1-4d20-b71e-cc4eb77c572f")
+	assert.NoError(t, err)
+	createdAt := time.Date(2021, 3, 4, 5, 6, 7, 8000000, time.UTC)
+
+	id := rekeyer.Rekey(old, createdAt)
+	assert.Equal(t, uuid.TimebasedVer7, id.Version())
+	assert.Equal(t, uuid.IETF, id.Variant())
+	assert.Equal(t, createdAt.UnixNano()/int64(time.Millisecond), id.UnixTimeMillis())
+	assert.Equal(t, id, rekeyer.Rekey(old, createdAt))
+	assert.True(t, rekeyer.Verify(old, id))
+
+	other, _ := uuid.NewRekeyer([]byte("other-secret"))
+	assert.NotEqual(t, id, other.Rekey(old, createdAt))
+	assert.False(t, other.Verify(old, id))
+
+	later := rekeyer.Rekey(uuid.New(uuid.RandomlyGeneratedVer4), createdAt.Add(time.Millisecond))
+	assert.Equal(t, -1, uuid.ComparePostgres(id, later))
+
+	_, err = uuid.NewRekeyer(nil)
+	assert.Error(t, err)
+
+}
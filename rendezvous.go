@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"hash/fnv"
+	"math"
+	"sort"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Node of the rendezvous hashing with the relative weight
+ */
+
+type RendezvousNode struct {
+	Name   string
+	Weight float64
+}
+
+/**
+	Weighted rendezvous (highest random weight) hashing of IDs to nodes
+
+    Each ID goes to the node with the highest score, so adding or removing the node moves
+    only the IDs that go to or come from that node. Share of IDs of the node is proportional to its weight.
+    Safe for concurrent use, the node set is immutable.
+ */
+
+type Rendezvous struct {
+	nodes []rendezvousNode
+}
+
+type rendezvousNode struct {
+	name   string
+	weight float64
+	seed   uint64
+}
+
+/**
+	Creates rendezvous hashing over the nodes, weights must be positive and names unique
+ */
+
+func NewRendezvous(nodes ...RendezvousNode) (*Rendezvous, error) {
+
+	if len(nodes) == 0 {
+		return nil, errors.New("no rendezvous nodes")
+	}
+
+	seen := make(map[string]bool, len(nodes))
+	list := make([]rendezvousNode, len(nodes))
+	for i, node := range nodes {
+		if !(node.Weight > 0) || math.IsInf(node.Weight, 1) {
+			return nil, errors.Errorf("invalid weight %v of node '%s'", node.Weight, node.Name)
+		}
+		if seen[node.Name] {
+			return nil, errors.Errorf("duplicate node '%s'", node.Name)
+		}
+		seen[node.Name] = true
+		h := fnv.New64a()
+		h.Write([]byte(node.Name))
+		list[i] = rendezvousNode{name: node.Name, weight: node.Weight, seed: h.Sum64()}
+	}
+
+	return &Rendezvous{nodes: list}, nil
+}
+
+/**
+	Gets nodes in the original order
+ */
+
+func (this *Rendezvous) Nodes() []RendezvousNode {
+	nodes := make([]RendezvousNode, len(this.nodes))
+	for i, node := range this.nodes {
+		nodes[i] = RendezvousNode{Name: node.name, Weight: node.weight}
+	}
+	return nodes
+}
+
+/**
+	Gets name of the node assigned to ID
+ */
+
+func (this *Rendezvous) Assign(id UUID) string {
+	best, bestScore := 0, math.Inf(-1)
+	for i, node := range this.nodes {
+		if score := node.score(id); score > bestScore || (score == bestScore && node.name < this.nodes[best].name) {
+			best, bestScore = i, score
+		}
+	}
+	return this.nodes[best].name
+}
+
+/**
+	Gets names of n nodes by descending score, e.g. primary and replicas of ID
+
+    Returns all nodes if n exceeds their number
+ */
+
+func (this *Rendezvous) AssignN(id UUID, n int) []string {
+
+	if n > len(this.nodes) {
+		n = len(this.nodes)
+	}
+	if n <= 0 {
+		return nil
+	}
+
+	type scored struct {
+		name  string
+		score float64
+	}
+	list := make([]scored, len(this.nodes))
+	for i, node := range this.nodes {
+		list[i] = scored{name: node.name, score: node.score(id)}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].score != list[j].score {
+			return list[i].score > list[j].score
+		}
+		return list[i].name < list[j].name
+	})
+
+	names := make([]string, n)
+	for i := range names {
+		names[i] = list[i].name
+	}
+	return names
+}
+
+/**
+	Logarithmic method, weight / -ln(u) for the uniform hash u in (0, 1)
+ */
+
+func (this rendezvousNode) score(id UUID) float64 {
+	h := mix64(pickScore(id, this.seed) ^ this.seed)
+	u := (float64(h>>11) + 0.5) / (1 << 53)
+	return this.weight / -math.Log(u)
+}
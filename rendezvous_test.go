@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRendezvous(t *testing.T) {
+
+	_, err := uuid.NewRendezvous()
+	assert.Error(t, err)
+	_, err = uuid.NewRendezvous(uuid.RendezvousNode{Name: "a", Weight: 0})
+	assert.Error(t, err)
+	_, err = uuid.NewRendezvous(uuid.RendezvousNode{Name: "a", Weight: 1}, uuid.RendezvousNode{Name: "a", Weight: 2})
+	assert.Error(t, err)
+
+	nodes := []uuid.RendezvousNode{{Name: "a", Weight: 1}, {Name: "b", Weight: 1}, {Name: "c", Weight: 2}}
+	r, err := uuid.NewRendezvous(nodes...)
+	assert.NoError(t, err)
+	assert.Equal(t, nodes, r.Nodes())
+
+	smaller, err := uuid.NewRendezvous(nodes[1:]...)
+	assert.NoError(t, err)
+
+	ids := sampleIDs(t, 20000)
+	counts := make(map[string]int)
+	for _, id := range ids {
+		node := r.Assign(id)
+		counts[node]++
+		if node != "a" {
+			assert.Equal(t, node, smaller.Assign(id))
+		}
+
+		replicas := r.AssignN(id, 2)
+		assert.Equal(t, 2, len(replicas))
+		assert.Equal(t, node, replicas[0])
+		assert.NotEqual(t, replicas[0], replicas[1])
+	}
+
+	assert.InDelta(t, 5000, counts["a"], 400)
+	assert.InDelta(t, 5000, counts["b"], 400)
+	assert.InDelta(t, 10000, counts["c"], 400)
+
+	assert.Equal(t, 3, len(r.AssignN(ids[0], 5)))
+	assert.Nil(t, r.AssignN(ids[0], 0))
+}
@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+var ErrorTraceExhausted = errors.New("trace exhausted")
+
+/**
+	Generator that records every generated UUID to the trace, one canonical UUID per line
+
+    The trace reproduces the exact sequence by ReplayGenerator, e.g. in integration tests and incident reproductions
+ */
+
+type Recorder struct {
+	sync.Mutex
+	next Generator
+	w    *bufio.Writer
+}
+
+/**
+	Creates recorder of the generator into the writer, call Flush before the writer is closed
+ */
+
+func NewRecorder(next Generator, w io.Writer) *Recorder {
+	return &Recorder{next: next, w: bufio.NewWriter(w)}
+}
+
+/**
+	Generates UUID by the wrapped generator and writes it to the trace
+
+    Next implements the Generator interface.
+ */
+
+func (this *Recorder) Next() (UUID, error) {
+	this.Lock()
+	defer this.Unlock()
+	id, err := this.next.Next()
+	if err != nil {
+		return id, err
+	}
+	var buf [37]byte
+	id.MarshalTextTo(buf[:36])
+	buf[36] = '\n'
+	if _, err := this.w.Write(buf[:]); err != nil {
+		return Empty, errors.Wrap(err, "write trace")
+	}
+	return id, nil
+}
+
+/**
+	Flushes buffered trace to the writer
+ */
+
+func (this *Recorder) Flush() error {
+	this.Lock()
+	defer this.Unlock()
+	return this.w.Flush()
+}
+
+/**
+	Generator that replays UUIDs from the trace written by Recorder
+
+    Empty lines and lines starting with # are skipped. Returns ErrorTraceExhausted after the last UUID.
+ */
+
+type ReplayGenerator struct {
+	sync.Mutex
+	scanner *bufio.Scanner
+	line    int
+	err     error
+}
+
+/**
+	Creates generator replaying the trace from the reader
+ */
+
+func NewReplayGenerator(r io.Reader) *ReplayGenerator {
+	return &ReplayGenerator{scanner: bufio.NewScanner(r)}
+}
+
+/**
+	Gets next UUID of the trace
+
+    Next implements the Generator interface.
+ */
+
+func (this *ReplayGenerator) Next() (UUID, error) {
+	if err := checkFrozen(); err != nil {
+		return Empty, err
+	}
+	this.Lock()
+	defer this.Unlock()
+
+	for this.err == nil {
+		if !this.scanner.Scan() {
+			this.err = ErrorTraceExhausted
+			if err := this.scanner.Err(); err != nil {
+				this.err = errors.Wrap(err, "read trace")
+			}
+			break
+		}
+		this.line++
+		line := strings.TrimSpace(this.scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		id, err := Parse(line)
+		if err != nil {
+			this.err = errors.Wrapf(err, "trace line %d", this.line)
+			break
+		}
+		return id, nil
+	}
+	return Empty, this.err
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordReplay(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	var trace bytes.Buffer
+	rec := uuid.NewRecorder(uuid.NewRandomGenerator(), &trace)
+
+	var recorded []uuid.UUID
+	for i := 0; i < 100; i++ {
+		id, err := rec.Next()
+		assert.NoError(t, err)
+		recorded = append(recorded, id)
+	}
+	assert.NoError(t, rec.Flush())
+
+	replay := uuid.NewReplayGenerator(strings.NewReader("# run 42\n\n" + trace.String()))
+	for _, expected := range recorded {
+		id, err := replay.Next()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, id)
+	}
+
+	_, err := replay.Next()
+	assert.Equal(t, uuid.ErrorTraceExhausted, err)
+	_, err = replay.Next()
+	assert.Equal(t, uuid.ErrorTraceExhausted, err)
+
+	replay = uuid.NewReplayGenerator(strings.NewReader(recorded[0].String() + "\nbroken\n"))
+	id, err := replay.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, recorded[0], id)
+	_, err = replay.Next()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "trace line 2")
+}
@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"sync/atomic"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Inclusive range of UUIDs in ComparePostgres order
+ */
+
+type Range struct {
+	Start UUID
+	End   UUID
+}
+
+/**
+	Gets range of all UUIDs with the same most significant bits as the prefix, bits in [0, 128]
+ */
+
+func RangeOfPrefix(prefix UUID, bits int) (Range, error) {
+	if bits < 0 || bits > 128 {
+		return Range{}, errors.Errorf("prefix bits %d out of [0, 128]", bits)
+	}
+	var msbMask, lsbMask uint64
+	switch {
+	case bits >= 64:
+		msbMask = ^uint64(0)
+		lsbMask = ^(^uint64(0) >> uint(bits-64))
+	default:
+		msbMask = ^(^uint64(0) >> uint(bits))
+	}
+	return Range{
+		Start: UUID{MostSigBits: prefix.MostSigBits & msbMask, LeastSigBits: prefix.LeastSigBits & lsbMask},
+		End:   UUID{MostSigBits: prefix.MostSigBits | ^msbMask, LeastSigBits: prefix.LeastSigBits | ^lsbMask},
+	}, nil
+}
+
+/**
+	Tells whether ID is in the range
+ */
+
+func (r Range) Contains(id UUID) bool {
+	return ComparePostgres(r.Start, id) <= 0 && ComparePostgres(id, r.End) <= 0
+}
+
+/**
+	Sub-ranges carved out for synthetic and test entities, generators never mint UUIDs inside them
+
+    Generators, RandomUUID, GenerateParallel, NewExpiring, TimeLayout.New and NextAt re-roll UUIDs
+    in the process-wide ranges and fail with ErrorRejected after DefaultMaxRerolls attempts.
+    ReplayGenerator returns the recorded trace as is, name-based UUIDs are not generated and not checked.
+ */
+
+type ReservedRanges []Range
+
+/**
+	Tells whether ID is in any of the ranges
+ */
+
+func (r ReservedRanges) Contains(id UUID) bool {
+	for _, rng := range r {
+		if rng.Contains(id) {
+			return true
+		}
+	}
+	return false
+}
+
+var reservedRanges atomic.Value
+
+func init() {
+	reservedRanges.Store(ReservedRanges(nil))
+}
+
+/**
+	Sets process-wide reserved ranges consulted by the generators, returns the previous ones
+ */
+
+func SetReservedRanges(r ReservedRanges) ReservedRanges {
+	return reservedRanges.Swap(append(ReservedRanges(nil), r...)).(ReservedRanges)
+}
+
+/**
+	Gets process-wide reserved ranges
+ */
+
+func GetReservedRanges() ReservedRanges {
+	return reservedRanges.Load().(ReservedRanges)
+}
+
+/**
+	Tells whether ID is in the process-wide reserved ranges
+ */
+
+func IsReserved(id UUID) bool {
+	return GetReservedRanges().Contains(id)
+}
+
+/**
+	Mints UUID until it is out of the process-wide reserved ranges, at most DefaultMaxRerolls attempts
+ */
+
+func rerollReserved(mint func() (UUID, error)) (UUID, error) {
+	reserved := GetReservedRanges()
+	for attempt := 0; attempt < DefaultMaxRerolls; attempt++ {
+		id, err := mint()
+		if err != nil || !reserved.Contains(id) {
+			return id, err
+		}
+	}
+	return Empty, errors.Wrap(ErrorRejected, "reserved range")
+}
+
+/**
+	Re-rolls UUIDs in the ranges, for generators that do not consult the process-wide reserved ranges
+ */
+
+func AvoidReserved(r ReservedRanges) Middleware {
+	return Reject(r.Contains)
+}
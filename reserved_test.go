@@ -0,0 +1,158 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRangeOfPrefix(t *testing.T) {
+
+	prefix := uuid.MustParse("ffff0000-0000-4000-8000-000000000000")
+
+	r, err := uuid.RangeOfPrefix(prefix, 16)
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.MustParse("ffff0000-0000-0000-0000-000000000000"), r.Start)
+	assert.Equal(t, uuid.MustParse("ffffffff-ffff-ffff-ffff-ffffffffffff"), r.End)
+	assert.True(t, r.Contains(uuid.MustParse("ffff1234-0000-4000-8000-000000000000")))
+	assert.False(t, r.Contains(uuid.MustParse("fffe1234-0000-4000-8000-000000000000")))
+
+	r, err = uuid.RangeOfPrefix(prefix, 72)
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.MustParse("ffff0000-0000-4000-8000-000000000000"), r.Start)
+	assert.Equal(t, uuid.MustParse("ffff0000-0000-4000-80ff-ffffffffffff"), r.End)
+
+	r, err = uuid.RangeOfPrefix(prefix, 128)
+	assert.NoError(t, err)
+	assert.Equal(t, prefix, r.Start)
+	assert.Equal(t, prefix, r.End)
+
+	_, err = uuid.RangeOfPrefix(prefix, 129)
+	assert.Error(t, err)
+}
+
+func TestReservedRanges(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	lower, _ := uuid.RangeOfPrefix(uuid.Empty, 2)
+	previous := uuid.SetReservedRanges(uuid.ReservedRanges{lower})
+	defer uuid.SetReservedRanges(previous)
+
+	assert.True(t, uuid.IsReserved(uuid.Empty))
+	assert.False(t, uuid.IsReserved(uuid.Max))
+
+	g := uuid.NewRandomGenerator()
+	for i := 0; i < 100; i++ {
+		id, err := g.Next()
+		assert.NoError(t, err)
+		assert.False(t, uuid.IsReserved(id))
+	}
+
+	ids, err := uuid.GenerateParallel(context.Background(), 3000, 2)
+	assert.NoError(t, err)
+	for _, id := range ids {
+		assert.False(t, uuid.IsReserved(id))
+	}
+
+	all, _ := uuid.RangeOfPrefix(uuid.Empty, 0)
+	uuid.SetReservedRanges(uuid.ReservedRanges{all})
+
+	_, err = g.Next()
+	assert.True(t, errors.Is(err, uuid.ErrorRejected))
+
+	v7, err := uuid.NewGenerator(uuid.TimebasedVer7)
+	assert.NoError(t, err)
+	_, err = v7.Next()
+	assert.True(t, errors.Is(err, uuid.ErrorRejected))
+
+	uuid.SetReservedRanges(nil)
+	_, err = v7.Next()
+	assert.NoError(t, err)
+}
+
+func TestAvoidReserved(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	upper, _ := uuid.RangeOfPrefix(uuid.Max, 2)
+	g := uuid.Chain(uuid.GeneratorFunc(uuid.RandomUUID), uuid.AvoidReserved(uuid.ReservedRanges{upper}))
+	for i := 0; i < 100; i++ {
+		id, err := g.Next()
+		assert.NoError(t, err)
+		assert.NotEqual(t, uint64(3), id.MostSigBits>>62)
+	}
+}
+
+func TestReservedRangesEverywhere(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	all, _ := uuid.RangeOfPrefix(uuid.Empty, 0)
+	previous := uuid.SetReservedRanges(uuid.ReservedRanges{all})
+	defer uuid.SetReservedRanges(previous)
+
+	v7, err := uuid.NewTimeGenerator(uuid.TimebasedVer7)
+	assert.NoError(t, err)
+	layout, err := uuid.NewTimeLayout(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Millisecond, 40)
+	assert.NoError(t, err)
+	provenance, err := uuid.NewProvenanceGenerator(7)
+	assert.NoError(t, err)
+	streams := uuid.NewStreamAllocator()
+
+	_, err = v7.NextGroup(4)
+	assert.True(t, errors.Is(err, uuid.ErrorRejected))
+	_, err = v7.NextAt(time.Now().Add(-time.Hour))
+	assert.True(t, errors.Is(err, uuid.ErrorRejected))
+	_, err = uuid.NewHLCGenerator().Next()
+	assert.True(t, errors.Is(err, uuid.ErrorRejected))
+	_, err = layout.New(time.Now())
+	assert.True(t, errors.Is(err, uuid.ErrorRejected))
+	_, err = uuid.RandomUUID()
+	assert.True(t, errors.Is(err, uuid.ErrorRejected))
+	_, err = uuid.NewExpiring(time.Hour)
+	assert.True(t, errors.Is(err, uuid.ErrorRejected))
+	_, err = provenance.Next()
+	assert.True(t, errors.Is(err, uuid.ErrorRejected))
+	_, err = streams.Next("orders")
+	assert.True(t, errors.Is(err, uuid.ErrorRejected))
+	assert.Equal(t, 0, streams.Len())
+
+	// the rejected stream UUID does not consume the counter
+	uuid.SetReservedRanges(nil)
+	first, err := streams.Next("orders")
+	assert.NoError(t, err)
+	uuid.SetReservedRanges(uuid.ReservedRanges{all})
+	_, err = streams.Next("orders")
+	assert.True(t, errors.Is(err, uuid.ErrorRejected))
+	uuid.SetReservedRanges(nil)
+	second, err := streams.Next("orders")
+	assert.NoError(t, err)
+	auditor := uuid.NewSequenceAuditor()
+	assert.True(t, auditor.Observe("orders", first))
+	assert.True(t, auditor.Observe("orders", second))
+
+	// sandbox skips the reserved position
+	sandbox := uuid.SandboxGenerator("tenant", 1)
+	head, _ := uuid.RangeOfPrefix(sandbox.At(0), 128)
+	uuid.SetReservedRanges(uuid.ReservedRanges{head})
+	id, err := sandbox.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, sandbox.At(1), id)
+	assert.Equal(t, uint64(2), sandbox.Position())
+}
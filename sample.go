@@ -0,0 +1,124 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"math/rand"
+)
+
+/**
+	Uniform random sample of k IDs from the stream of unknown length, reservoir sampling
+
+    Not safe for concurrent use
+ */
+
+type Reservoir struct {
+	k     int
+	seen  int64
+	items []UUID
+	rnd   *rand.Rand
+}
+
+/**
+	Creates reservoir of k IDs, random source can be nil for the global one of math/rand
+ */
+
+func NewReservoir(k int, rnd *rand.Rand) *Reservoir {
+	if k < 0 {
+		k = 0
+	}
+	return &Reservoir{k: k, items: make([]UUID, 0, k), rnd: rnd}
+}
+
+/**
+	Offers ID to the sample
+ */
+
+func (this *Reservoir) Add(id UUID) {
+	this.seen++
+	if len(this.items) < this.k {
+		this.items = append(this.items, id)
+		return
+	}
+	if this.k == 0 {
+		return
+	}
+	var j int64
+	if this.rnd != nil {
+		j = this.rnd.Int63n(this.seen)
+	} else {
+		j = rand.Int63n(this.seen)
+	}
+	if j < int64(this.k) {
+		this.items[j] = id
+	}
+}
+
+/**
+	Gets number of offered IDs
+ */
+
+func (this *Reservoir) Seen() int64 {
+	return this.seen
+}
+
+/**
+	Gets the sample, all offered IDs if there were not more than k of them
+ */
+
+func (this *Reservoir) Sample() []UUID {
+	return append([]UUID(nil), this.items...)
+}
+
+/**
+	Gets uniform random sample of k IDs from the slice
+ */
+
+func SampleSlice(ids []UUID, k int) []UUID {
+	r := NewReservoir(k, nil)
+	for _, id := range ids {
+		r.Add(id)
+	}
+	return r.Sample()
+}
+
+/**
+	Picks one ID by the seed, e.g. canary selection, Empty for no IDs
+
+    The pick depends only on the set of IDs and the seed, not on the order,
+    adding more IDs changes the pick only in favor of one of the added IDs.
+ */
+
+func PickDeterministic(ids []UUID, seed uint64) UUID {
+	best, bestScore := Empty, uint64(0)
+	for i, id := range ids {
+		score := pickScore(id, seed)
+		if i == 0 || score > bestScore || (score == bestScore && ComparePostgres(id, best) < 0) {
+			best, bestScore = id, score
+		}
+	}
+	return best
+}
+
+/**
+	Tells whether ID belongs to the consistent sample of the rate in [0, 1] by the seed
+
+    Same ID and seed are always in or out of the sample on every host, higher rate keeps all IDs of the lower one
+ */
+
+func InSample(id UUID, seed uint64, rate float64) bool {
+	if rate <= 0 {
+		return false
+	}
+	if rate >= 1 {
+		return true
+	}
+	return float64(pickScore(id, seed)>>11) < rate*(1<<53)
+}
+
+func pickScore(id UUID, seed uint64) uint64 {
+	return mix64(mix64(id.MostSigBits^seed) ^ id.LeastSigBits)
+}
@@ -0,0 +1,25 @@
+//go:build go1.23
+// +build go1.23
+
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"iter"
+)
+
+/**
+	Gets uniform random sample of k IDs from the sequence of unknown length
+ */
+
+func Sample(ids iter.Seq[UUID], k int) []UUID {
+	r := NewReservoir(k, nil)
+	for id := range ids {
+		r.Add(id)
+	}
+	return r.Sample()
+}
@@ -0,0 +1,28 @@
+//go:build go1.23
+// +build go1.23
+
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSample(t *testing.T) {
+
+	ids := sampleIDs(t, 100)
+	sample := uuid.Sample(slices.Values(ids), 5)
+	assert.Equal(t, 5, len(sample))
+	for _, id := range sample {
+		assert.Contains(t, ids, id)
+	}
+	assert.Equal(t, ids[:3], uuid.Sample(slices.Values(ids[:3]), 5))
+}
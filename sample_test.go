@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	fixtureLock   sync.Mutex
+	fixtureRandom = rand.New(rand.NewSource(1))
+)
+
+/**
+	Gets pseudo-random version 4 UUID without minting, so the tests of ID processing also run in the uuid_frozen build
+ */
+
+func fixtureID() uuid.UUID {
+	fixtureLock.Lock()
+	defer fixtureLock.Unlock()
+	return uuid.UUID{
+		MostSigBits:  fixtureRandom.Uint64()&^0xF000 | 0x4000,
+		LeastSigBits: fixtureRandom.Uint64()&^(0x3<<62) | 0x2<<62,
+	}
+}
+
+func sampleIDs(t *testing.T, n int) []uuid.UUID {
+	ids := make([]uuid.UUID, n)
+	for i := range ids {
+		ids[i] = fixtureID()
+	}
+	return ids
+}
+
+func TestReservoir(t *testing.T) {
+
+	ids := sampleIDs(t, 10)
+
+	assert.Equal(t, 3, len(uuid.SampleSlice(ids, 3)))
+	assert.Equal(t, ids[:2], uuid.SampleSlice(ids[:2], 3))
+	assert.Equal(t, 0, len(uuid.SampleSlice(ids, 0)))
+
+	hits := make(map[uuid.UUID]int)
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		r := uuid.NewReservoir(2, rnd)
+		for _, id := range ids {
+			r.Add(id)
+		}
+		assert.Equal(t, int64(10), r.Seen())
+		for _, id := range r.Sample() {
+			hits[id]++
+		}
+	}
+	for _, id := range ids {
+		assert.InDelta(t, 2000, hits[id], 300)
+	}
+}
+
+func TestPickDeterministic(t *testing.T) {
+
+	ids := sampleIDs(t, 20)
+	assert.Equal(t, uuid.Empty, uuid.PickDeterministic(nil, 1))
+
+	pick := uuid.PickDeterministic(ids, 42)
+	assert.Contains(t, ids, pick)
+
+	shuffled := append([]uuid.UUID(nil), ids...)
+	rand.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+	assert.Equal(t, pick, uuid.PickDeterministic(shuffled, 42))
+
+	more := append(shuffled, sampleIDs(t, 5)...)
+	next := uuid.PickDeterministic(more, 42)
+	assert.True(t, next == pick || !containsID(ids, next))
+
+	picks := make(map[uuid.UUID]bool)
+	for seed := uint64(0); seed < 100; seed++ {
+		picks[uuid.PickDeterministic(ids, seed)] = true
+	}
+	assert.True(t, len(picks) > 5)
+}
+
+func TestInSample(t *testing.T) {
+
+	ids := sampleIDs(t, 10000)
+	in10, in50 := 0, 0
+	for _, id := range ids {
+		a := uuid.InSample(id, 7, 0.1)
+		b := uuid.InSample(id, 7, 0.5)
+		assert.Equal(t, a, uuid.InSample(id, 7, 0.1))
+		assert.False(t, a && !b)
+		if a {
+			in10++
+		}
+		if b {
+			in50++
+		}
+		assert.False(t, uuid.InSample(id, 7, 0))
+		assert.True(t, uuid.InSample(id, 7, 1))
+	}
+	assert.InDelta(t, 1000, in10, 200)
+	assert.InDelta(t, 5000, in50, 400)
+}
+
+func containsID(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
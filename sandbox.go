@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
+)
+
+/**
+	Reproducible sequence of version 4 UUIDs of the tenant, e.g. demo and sandbox environments reset to identical states
+
+    The n-th UUID is SHA-256 of the tenant key and n, the tenant key is SHA-256 of the seed and the tenant,
+    so sequences of different tenants or seeds are isolated, and the sequence does not depend
+    on the Go release or the platform. Not suitable for production IDs, anyone knowing the seed can predict them.
+ */
+
+type Sandbox struct {
+	sync.Mutex
+	key     [sha256.Size]byte
+	counter uint64
+}
+
+/**
+	Creates sandbox generator of the tenant positioned at the start of the sequence
+ */
+
+func SandboxGenerator(tenant string, seed uint64) *Sandbox {
+	var data [8]byte
+	binary.BigEndian.PutUint64(data[:], seed)
+	h := sha256.New()
+	h.Write(data[:])
+	h.Write([]byte(tenant))
+	this := &Sandbox{}
+	h.Sum(this.key[:0])
+	return this
+}
+
+/**
+	Generates next UUID of the sequence, positions of the UUIDs in the reserved ranges are skipped
+
+    Next implements the Generator interface.
+ */
+
+func (this *Sandbox) Next() (UUID, error) {
+	if err := checkFrozen(); err != nil {
+		return Empty, err
+	}
+	this.Lock()
+	defer this.Unlock()
+	return rerollReserved(func() (UUID, error) {
+		n := this.counter
+		this.counter++
+		return this.At(n), nil
+	})
+}
+
+/**
+	Gets the n-th UUID of the sequence starting from 0 without moving the position
+
+    Unlike Next the UUIDs in the reserved ranges are not skipped
+ */
+
+func (this *Sandbox) At(n uint64) UUID {
+	var data [sha256.Size + 8]byte
+	copy(data[:], this.key[:])
+	binary.BigEndian.PutUint64(data[sha256.Size:], n)
+	sum := sha256.Sum256(data[:])
+	return UUID{
+		MostSigBits:  (binary.BigEndian.Uint64(sum[:]) &^ versionMask) | uint64(RandomlyGeneratedVer4)<<12,
+		LeastSigBits: (binary.BigEndian.Uint64(sum[8:]) & counterMask) | variantIETFBits,
+	}
+}
+
+/**
+	Gets number of generated UUIDs, the position of the next one
+ */
+
+func (this *Sandbox) Position() uint64 {
+	this.Lock()
+	defer this.Unlock()
+	return this.counter
+}
+
+/**
+	Moves to the position, e.g. restores the state saved with the sandbox snapshot
+ */
+
+func (this *Sandbox) Seek(n uint64) {
+	this.Lock()
+	defer this.Unlock()
+	this.counter = n
+}
+
+/**
+	Moves to the start of the sequence
+ */
+
+func (this *Sandbox) Reset() {
+	this.Seek(0)
+}
@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSandboxGenerator(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	acme := uuid.SandboxGenerator("acme", 1)
+
+	var first []uuid.UUID
+	for i := 0; i < 10; i++ {
+		id, err := acme.Next()
+		assert.NoError(t, err)
+		assert.Equal(t, uuid.RandomlyGeneratedVer4, id.Version())
+		assert.Equal(t, uuid.IETF, id.Variant())
+		first = append(first, id)
+	}
+	assert.Equal(t, uint64(10), acme.Position())
+	assert.Equal(t, uuid.MustParse("25df4d6e-eb11-4dff-9210-ddcd97154eb4"), first[0])
+
+	acme.Reset()
+	for _, expected := range first {
+		id, _ := acme.Next()
+		assert.Equal(t, expected, id)
+	}
+
+	acme.Seek(5)
+	id, _ := acme.Next()
+	assert.Equal(t, first[5], id)
+	assert.Equal(t, first[7], acme.At(7))
+
+	again, _ := uuid.SandboxGenerator("acme", 1).Next()
+	assert.Equal(t, first[0], again)
+
+	other, _ := uuid.SandboxGenerator("globex", 1).Next()
+	assert.NotEqual(t, first[0], other)
+	reseeded, _ := uuid.SandboxGenerator("acme", 2).Next()
+	assert.NotEqual(t, first[0], reseeded)
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+/**
+	Longest run of hex digits and hyphens buffered by SplitUUIDs, longer runs are emitted in pieces
+ */
+
+const maxCandidateLen = 64
+
+/**
+	Split function for bufio.Scanner extracting UUID candidates from arbitrary text like logs and CSVs
+
+    Candidates are runs of hex digits and hyphens of at least 32 characters with the outer hyphens trimmed,
+    shorter runs like numbers and dates are skipped. Candidates are not validated, parse them with ParseBytes.
+ */
+
+func SplitUUIDs(data []byte, atEOF bool) (advance int, token []byte, err error) {
+
+	start := 0
+	for {
+		for start < len(data) && !isCandidateByte(data[start]) {
+			start++
+		}
+		if start == len(data) {
+			return start, nil, nil
+		}
+
+		end := start
+		for end < len(data) && end-start < maxCandidateLen && isCandidateByte(data[end]) {
+			end++
+		}
+		if end == len(data) && !atEOF && end-start < maxCandidateLen {
+			// run may continue in the next chunk
+			return start, nil, nil
+		}
+
+		candidate := bytes.Trim(data[start:end], "-")
+		if len(candidate) >= 32 {
+			return end, candidate, nil
+		}
+		start = end
+	}
+}
+
+func isCandidateByte(c byte) bool {
+	return c == '-' || hexIndex[c] >= 0
+}
+
+/**
+	Calls the function for every UUID found in the text, malformed candidates are skipped
+ */
+
+func ScanUUIDs(r io.Reader, fn func(UUID) error) error {
+	return ScanUUIDsFunc(r, fn, nil)
+}
+
+/**
+	Calls the function for every UUID found in the text and the malformed function, if not nil,
+    for candidates failing to parse, e.g. truncated IDs or 40 hex digits of SHA-1
+
+    Stops on the first error returned by the callbacks or the reader
+ */
+
+func ScanUUIDsFunc(r io.Reader, fn func(UUID) error, malformed func(token []byte) error) error {
+
+	scanner := bufio.NewScanner(r)
+	scanner.Split(SplitUUIDs)
+
+	for scanner.Scan() {
+		token := scanner.Bytes()
+		id, err := ParseBytes(token)
+		if err != nil {
+			if malformed != nil {
+				if err := malformed(token); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if err := fn(id); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
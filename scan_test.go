@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScanUUIDs(t *testing.T) {
+
+	text := `2023-05-01T10:00:00Z INFO request id=534b44a1-9bf1-3d20-b71e-cc4eb77c572f user=42
+2023-05-01T10:00:01Z WARN urn:uuid:017F22E2-79B0-7CC3-98C4-DC0C0C07398F retried
+"6ba7b810-9dad-11d1-80b4-00c04fd430c8",17,deadbeef
+sha1=da39a3ee5e6b4b0d3255bfef95601890afd80709 truncated=534b44a1-9bf1-3d20-b71e-cc4eb77c57
+hex=534b44a19bf13d20b71ecc4eb77c572f`
+
+	var ids []string
+	var bad []string
+	err := uuid.ScanUUIDsFunc(iotest.OneByteReader(strings.NewReader(text)), func(id uuid.UUID) error {
+		ids = append(ids, id.String())
+		return nil
+	}, func(token []byte) error {
+		bad = append(bad, string(token))
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"534b44a1-9bf1-3d20-b71e-cc4eb77c572f",
+		"017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"534b44a1-9bf1-3d20-b71e-cc4eb77c572f",
+	}, ids)
+	assert.Equal(t, []string{"da39a3ee5e6b4b0d3255bfef95601890afd80709", "534b44a1-9bf1-3d20-b71e-cc4eb77c57"}, bad)
+
+	stop := errors.New("stop")
+	count := 0
+	err = uuid.ScanUUIDs(strings.NewReader(text), func(id uuid.UUID) error {
+		count++
+		return stop
+	})
+	assert.Equal(t, stop, err)
+	assert.Equal(t, 1, count)
+
+	// long hex blobs do not grow the buffer
+	count = 0
+	err = uuid.ScanUUIDs(strings.NewReader(strings.Repeat("a", 1<<20)+" 534b44a1-9bf1-3d20-b71e-cc4eb77c572f"), func(id uuid.UUID) error {
+		count++
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
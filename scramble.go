@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+const (
+	scrambleRounds   = 4
+	scrambleHalfMask = uint64(1)<<61 - 1
+	scrambleLowMask  = uint64(1)<<62 - 1
+)
+
+/**
+	Keyed permutation of UUIDs for public exposure of sequential or time-ordered IDs
+
+    A 4-round Feistel network over the 122 bits without version and variant, so scrambled IDs
+    are valid UUIDs of the same version and Unscramble restores the original one.
+    Guarantees: the permutation is bijective, deterministic for the key, and hides the order and timestamps
+    from casual observers. It is not an encryption: a chosen-plaintext attacker can recover the permutation,
+    use Signer or Rekeyer when IDs must be unforgeable.
+ */
+
+type Scrambler struct {
+	keys [scrambleRounds]uint64
+}
+
+/**
+	Creates scrambler with the secret key
+ */
+
+func NewScrambler(key []byte) (*Scrambler, error) {
+	if len(key) == 0 {
+		return nil, errors.New("empty scramble key")
+	}
+	digest := sha256.Sum256(key)
+	s := &Scrambler{}
+	for i := range s.keys {
+		s.keys[i] = binary.BigEndian.Uint64(digest[i*8:])
+	}
+	return s, nil
+}
+
+/**
+	Gets scrambled UUID of the same version and variant
+ */
+
+func (this *Scrambler) Scramble(id UUID) UUID {
+	l, r := scrambleSplit(id)
+	for _, k := range this.keys {
+		l, r = r, l^scrambleRound(r, k)
+	}
+	return scrambleJoin(id, l, r)
+}
+
+/**
+	Gets original UUID of the scrambled one
+ */
+
+func (this *Scrambler) Unscramble(id UUID) UUID {
+	l, r := scrambleSplit(id)
+	for i := scrambleRounds - 1; i >= 0; i-- {
+		l, r = r^scrambleRound(l, this.keys[i]), l
+	}
+	return scrambleJoin(id, l, r)
+}
+
+func scrambleRound(x, k uint64) uint64 {
+	return mix64(x^k) & scrambleHalfMask
+}
+
+/**
+	Splits 122 payload bits into two 61-bit halves
+ */
+
+func scrambleSplit(id UUID) (uint64, uint64) {
+	hi := (id.MostSigBits>>16)<<12 | id.MostSigBits&0xFFF // 60 bits
+	lo := id.LeastSigBits & scrambleLowMask               // 62 bits
+	return hi<<1 | lo>>61, lo & scrambleHalfMask
+}
+
+/**
+	Joins two 61-bit halves keeping version and variant of the original UUID
+ */
+
+func scrambleJoin(id UUID, l, r uint64) UUID {
+	hi := l >> 1
+	lo := (l&1)<<61 | r
+	return UUID{
+		MostSigBits:  (hi>>12)<<16 | id.MostSigBits&versionMask | hi&0xFFF,
+		LeastSigBits: id.LeastSigBits&^scrambleLowMask | lo,
+	}
+}
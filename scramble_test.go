@@ -0,0 +1,45 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/codeallergy/uuid/uuidtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScrambler(t *testing.T) {
+
+	s, err := uuid.NewScrambler([]byte("secret"))
+	assert.NoError(t, err)
+	other, err := uuid.NewScrambler([]byte("other"))
+	assert.NoError(t, err)
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		id := uuidtest.ArbitraryBits(r)
+		scrambled := s.Scramble(id)
+		assert.Equal(t, id.Version(), scrambled.Version())
+		assert.Equal(t, id.LeastSigBits>>62, scrambled.LeastSigBits>>62)
+		assert.Equal(t, id, s.Unscramble(scrambled))
+		assert.Equal(t, scrambled, s.Scramble(id))
+		assert.NotEqual(t, scrambled, other.Scramble(id))
+	}
+
+	// sequential IDs do not look sequential
+	a := uuid.New(uuid.TimebasedVer7)
+	b := a
+	b.MostSigBits++
+	sa, sb := s.Scramble(a), s.Scramble(b)
+	assert.NotEqual(t, sa.MostSigBits>>16, sb.MostSigBits>>16)
+	assert.NotEqual(t, sa.LeastSigBits, sb.LeastSigBits)
+
+	_, err = uuid.NewScrambler(nil)
+	assert.Error(t, err)
+}
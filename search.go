@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"encoding/binary"
+	"os"
+	"sort"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Finds position of the target in the slice sorted by ComparePostgres
+
+    Returns the index of the target and true if found, otherwise the index where it would be inserted and false
+ */
+
+func SearchSorted(ids []UUID, target UUID) (int, bool) {
+	i := sort.Search(len(ids), func(i int) bool { return ComparePostgres(ids[i], target) >= 0 })
+	return i, i < len(ids) && ids[i] == target
+}
+
+/**
+	Read-only membership index over a file of 16-byte big-endian records sorted by ComparePostgres
+
+    The file is memory-mapped on unix platforms, so huge static ID sets are served from the page cache
+    without loading them into a map, other platforms read the file into memory.
+    Runs spilled by DuplicateFinder and output of sort over MarshalBinary records have this layout.
+    Safe for concurrent use until Close.
+ */
+
+type SortedIndex struct {
+	data  []byte
+	unmap func() error
+}
+
+/**
+	Opens index over the sorted file, the length of the file must be a multiple of 16 bytes
+ */
+
+func OpenSortedIndex(name string) (*SortedIndex, error) {
+
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, errors.Wrap(err, "open index")
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, errors.Wrap(err, "stat index")
+	}
+	if fi.Size()%16 != 0 {
+		return nil, errors.Errorf("index %s size %d is not a multiple of 16 bytes", name, fi.Size())
+	}
+
+	if fi.Size() == 0 {
+		return &SortedIndex{}, nil
+	}
+
+	data, unmap, err := mapFile(f, int(fi.Size()))
+	if err != nil {
+		return nil, errors.Wrap(err, "map index")
+	}
+	return &SortedIndex{data: data, unmap: unmap}, nil
+}
+
+/**
+	Gets number of records
+ */
+
+func (this *SortedIndex) Len() int {
+	return len(this.data) / 16
+}
+
+/**
+	Gets record at the position
+ */
+
+func (this *SortedIndex) At(i int) UUID {
+	record := this.data[i*16 : i*16+16]
+	return UUID{MostSigBits: binary.BigEndian.Uint64(record), LeastSigBits: binary.BigEndian.Uint64(record[8:])}
+}
+
+/**
+	Finds position of the target like SearchSorted
+ */
+
+func (this *SortedIndex) Search(target UUID) (int, bool) {
+	n := this.Len()
+	i := sort.Search(n, func(i int) bool { return ComparePostgres(this.At(i), target) >= 0 })
+	return i, i < n && this.At(i) == target
+}
+
+/**
+	Returns true if the index contains the UUID
+ */
+
+func (this *SortedIndex) Contains(id UUID) bool {
+	_, ok := this.Search(id)
+	return ok
+}
+
+/**
+	Releases the mapping, the index must not be used after the call
+ */
+
+func (this *SortedIndex) Close() error {
+	data, unmap := this.data, this.unmap
+	this.data, this.unmap = nil, nil
+	if unmap != nil && data != nil {
+		return unmap()
+	}
+	return nil
+}
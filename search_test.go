@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchSorted(t *testing.T) {
+
+	ids := make([]uuid.UUID, 1000)
+	for i := range ids {
+		ids[i] = uuid.New(uuid.RandomlyGeneratedVer4)
+		ids[i].MostSigBits |= uint64(i) << 52
+		ids[i].SetCounter(int64(i * 7))
+	}
+	sort.Slice(ids, func(i, j int) bool { return uuid.ComparePostgres(ids[i], ids[j]) < 0 })
+
+	missing := ids[500]
+	missing.LeastSigBits++
+
+	for i, id := range ids {
+		pos, ok := uuid.SearchSorted(ids, id)
+		assert.True(t, ok)
+		assert.Equal(t, i, pos)
+	}
+	pos, ok := uuid.SearchSorted(ids, missing)
+	assert.False(t, ok)
+	assert.Equal(t, 501, pos)
+
+	_, ok = uuid.SearchSorted(nil, missing)
+	assert.False(t, ok)
+
+	name := filepath.Join(t.TempDir(), "ids.idx")
+	var data []byte
+	for _, id := range ids {
+		b, _ := id.MarshalBinary()
+		data = append(data, b...)
+	}
+	assert.NoError(t, os.WriteFile(name, data, 0644))
+
+	index, err := uuid.OpenSortedIndex(name)
+	assert.NoError(t, err)
+	assert.Equal(t, 1000, index.Len())
+	assert.Equal(t, ids[3], index.At(3))
+	for _, id := range ids {
+		assert.True(t, index.Contains(id))
+	}
+	pos, ok = index.Search(missing)
+	assert.False(t, ok)
+	assert.Equal(t, 501, pos)
+	assert.NoError(t, index.Close())
+	assert.NoError(t, index.Close())
+
+	assert.NoError(t, os.WriteFile(name, nil, 0644))
+	index, err = uuid.OpenSortedIndex(name)
+	assert.NoError(t, err)
+	assert.False(t, index.Contains(missing))
+	assert.NoError(t, index.Close())
+
+	assert.NoError(t, os.WriteFile(name, data[:17], 0644))
+	_, err = uuid.OpenSortedIndex(name)
+	assert.Error(t, err)
+}
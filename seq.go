@@ -0,0 +1,59 @@
+//go:build go1.23
+// +build go1.23
+
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"iter"
+)
+
+/**
+	Gets endless sequence of UUIDs from the generator, stops on the first generator error
+
+    Use GenerateSeq2 to see the error
+ */
+
+func GenerateSeq(g Generator) iter.Seq[UUID] {
+	return func(yield func(UUID) bool) {
+		for {
+			id, err := g.Next()
+			if err != nil || !yield(id) {
+				return
+			}
+		}
+	}
+}
+
+/**
+	Gets endless sequence of UUIDs from the generator, yields the first generator error and stops
+ */
+
+func GenerateSeq2(g Generator) iter.Seq2[UUID, error] {
+	return func(yield func(UUID, error) bool) {
+		for {
+			id, err := g.Next()
+			if !yield(id, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+/**
+	Parses each line by Parse, malformed lines yield Empty with the error and the sequence goes on
+ */
+
+func ParseSeq(lines iter.Seq[string]) iter.Seq2[UUID, error] {
+	return func(yield func(UUID, error) bool) {
+		for line := range lines {
+			if !yield(Parse(line)) {
+				return
+			}
+		}
+	}
+}
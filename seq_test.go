@@ -0,0 +1,81 @@
+//go:build go1.23
+// +build go1.23
+
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type failingGenerator struct {
+	left int
+}
+
+func (this *failingGenerator) Next() (uuid.UUID, error) {
+	if this.left == 0 {
+		return uuid.Empty, errors.New("exhausted")
+	}
+	this.left--
+	return uuid.RandomUUID()
+}
+
+func TestGenerateSeq(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	var ids []uuid.UUID
+	for id := range uuid.GenerateSeq(uuid.NewRandomGenerator()) {
+		ids = append(ids, id)
+		if len(ids) == 5 {
+			break
+		}
+	}
+	assert.Equal(t, 5, len(ids))
+	assert.Equal(t, uuid.RandomlyGeneratedVer4, ids[4].Version())
+
+	ids = slices.Collect(uuid.GenerateSeq(&failingGenerator{left: 3}))
+	assert.Equal(t, 3, len(ids))
+
+	n := 0
+	var last error
+	for _, err := range uuid.GenerateSeq2(&failingGenerator{left: 2}) {
+		n++
+		last = err
+	}
+	assert.Equal(t, 3, n)
+	assert.Error(t, last)
+}
+
+func TestParseSeq(t *testing.T) {
+
+	id, _ := uuid.RandomUUID()
+	lines := []string{id.String(), "bad", id.String()}
+
+	var ids []uuid.UUID
+	var errs int
+	for parsed, err := range uuid.ParseSeq(slices.Values(lines)) {
+		if err != nil {
+			errs++
+			continue
+		}
+		ids = append(ids, parsed)
+	}
+	assert.Equal(t, []uuid.UUID{id, id}, ids)
+	assert.Equal(t, 1, errs)
+
+	for range uuid.ParseSeq(slices.Values(lines)) {
+		break
+	}
+}
@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+/**
+	Missing UUIDs of the stream between two consumed ones
+ */
+
+type StreamGap struct {
+	Stream  string
+	After   UUID
+	Before  UUID
+	Missing uint64
+}
+
+/**
+	UUID consumed not after the previous one of the stream, Duplicate if equal
+ */
+
+type StreamDisorder struct {
+	Stream    string
+	Previous  UUID
+	ID        UUID
+	Duplicate bool
+}
+
+/**
+	Audit of the consumed per-stream UUIDs minted by StreamAllocator, e.g. validation of exactly-once pipelines
+
+    Out-of-order and duplicate UUIDs are detected anywhere. The counter of the stream continues across milliseconds,
+    so every UUID must carry the counter of the previous one plus one, otherwise the skipped counters are
+    reported as missing. Not safe for concurrent use.
+ */
+
+type SequenceAuditor struct {
+	last     map[string]UUID
+	gaps     []StreamGap
+	disorder []StreamDisorder
+	observed int64
+}
+
+/**
+	Creates empty auditor
+ */
+
+func NewSequenceAuditor() *SequenceAuditor {
+	return &SequenceAuditor{last: make(map[string]UUID)}
+}
+
+/**
+	Checks the next consumed UUID of the stream, returns false on gap, disorder or duplicate
+ */
+
+func (this *SequenceAuditor) Observe(stream string, id UUID) bool {
+
+	this.observed++
+
+	prev, ok := this.last[stream]
+	if !ok {
+		this.last[stream] = id
+		return true
+	}
+
+	if c := ComparePostgres(prev, id); c >= 0 {
+		this.disorder = append(this.disorder, StreamDisorder{Stream: stream, Previous: prev, ID: id, Duplicate: c == 0})
+		return false
+	}
+	this.last[stream] = id
+
+	_, prevCounter := streamPosition(prev)
+	_, counter := streamPosition(id)
+
+	if missing := (counter - prevCounter - 1) & streamCounterMask; missing > 0 {
+		this.gaps = append(this.gaps, StreamGap{Stream: stream, After: prev, Before: id, Missing: missing})
+		return false
+	}
+	return true
+}
+
+/**
+	Gets detected gaps in the order of detection
+ */
+
+func (this *SequenceAuditor) Gaps() []StreamGap {
+	return this.gaps
+}
+
+/**
+	Gets detected out-of-order and duplicate UUIDs in the order of detection
+ */
+
+func (this *SequenceAuditor) Disorder() []StreamDisorder {
+	return this.disorder
+}
+
+/**
+	Gets number of observed UUIDs
+ */
+
+func (this *SequenceAuditor) Observed() int64 {
+	return this.observed
+}
+
+/**
+	Tells whether no gap, disorder or duplicate was detected
+ */
+
+func (this *SequenceAuditor) OK() bool {
+	return len(this.gaps) == 0 && len(this.disorder) == 0
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSequenceAuditor(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	alloc := uuid.NewStreamAllocator()
+	now := time.Unix(1700000000, 0)
+	alloc.SetClock(func() time.Time { return now })
+
+	var orders, users []uuid.UUID
+	for i := 0; i < 10; i++ {
+		id, err := alloc.Next("orders")
+		assert.NoError(t, err)
+		orders = append(orders, id)
+		id, err = alloc.Next("users")
+		assert.NoError(t, err)
+		users = append(users, id)
+	}
+
+	a := uuid.NewSequenceAuditor()
+	for i := range orders {
+		assert.True(t, a.Observe("orders", orders[i]))
+		assert.True(t, a.Observe("users", users[i]))
+	}
+	assert.True(t, a.OK())
+	assert.Equal(t, int64(20), a.Observed())
+
+	a = uuid.NewSequenceAuditor()
+	a.Observe("orders", orders[0])
+	a.Observe("orders", orders[1])
+	assert.False(t, a.Observe("orders", orders[5]))
+	assert.False(t, a.Observe("orders", orders[5]))
+	assert.False(t, a.Observe("orders", orders[4]))
+	assert.True(t, a.Observe("orders", orders[6]))
+
+	assert.Equal(t, []uuid.StreamGap{{Stream: "orders", After: orders[1], Before: orders[5], Missing: 3}}, a.Gaps())
+	assert.Equal(t, []uuid.StreamDisorder{
+		{Stream: "orders", Previous: orders[5], ID: orders[5], Duplicate: true},
+		{Stream: "orders", Previous: orders[5], ID: orders[4]},
+	}, a.Disorder())
+	assert.False(t, a.OK())
+}
+
+func TestSequenceAuditorAcrossMillis(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	alloc := uuid.NewStreamAllocator()
+	now := time.Unix(1700000000, 0)
+	alloc.SetClock(func() time.Time { return now })
+
+	var ids []uuid.UUID
+	for i := 0; i < 6; i++ {
+		id, err := alloc.Next("orders")
+		assert.NoError(t, err)
+		ids = append(ids, id)
+		now = now.Add(time.Millisecond)
+	}
+	assert.NotEqual(t, ids[2].UnixTimeMillis(), ids[3].UnixTimeMillis())
+
+	a := uuid.NewSequenceAuditor()
+	for _, id := range ids {
+		if id != ids[3] {
+			a.Observe("orders", id)
+		}
+	}
+	assert.Equal(t, []uuid.StreamGap{{Stream: "orders", After: ids[2], Before: ids[4], Missing: 1}}, a.Gaps())
+	assert.Empty(t, a.Disorder())
+
+	a = uuid.NewSequenceAuditor()
+	for _, id := range ids {
+		assert.True(t, a.Observe("orders", id))
+	}
+	assert.True(t, a.OK())
+}
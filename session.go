@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"crypto/subtle"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+const (
+	base64URLAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+
+	sessionTokenLen = 22
+)
+
+var (
+	base64URLIndex = buildIndex(base64URLAlphabet, false)
+
+	ErrorInvalidSessionToken = errors.New("invalid session token")
+)
+
+/**
+	Gets session token safe as HTTP header and cookie value, 22 characters of URL-safe base64 without padding
+ */
+
+func (this UUID) SessionToken() string {
+	return this.Base64URL()
+}
+
+/**
+	Validates session token and returns version 4 UUID
+
+    Input longer than the token is rejected before any processing, the characters are validated
+    without data-dependent branches, and any failure returns the same ErrorInvalidSessionToken.
+ */
+
+func ParseSessionToken(s string) (UUID, error) {
+
+	if len(s) != sessionTokenLen {
+		return Empty, ErrorInvalidSessionToken
+	}
+
+	var hi, lo uint64
+	invalid := 0
+
+	for i := 0; i < sessionTokenLen-1; i++ {
+		digit := int(base64URLIndex[s[i]])
+		invalid |= digit >> 8 // -1 sets all bits
+		hi = (hi << 6) | (lo >> 58)
+		lo = (lo << 6) | uint64(digit&0x3F)
+	}
+
+	// 22 characters carry 132 bits, the lowest 4 bits of the last one must be zero to keep the encoding canonical
+	digit := int(base64URLIndex[s[sessionTokenLen-1]])
+	invalid |= digit>>8 | digit&0xF
+	hi = (hi << 2) | (lo >> 62)
+	lo = (lo << 2) | uint64((digit>>4)&0x3)
+
+	id := UUID{MostSigBits: hi, LeastSigBits: lo}
+
+	valid := subtle.ConstantTimeEq(int32(invalid), 0) &
+		subtle.ConstantTimeEq(int32(id.MostSigBits&versionMask), int32(uint64(RandomlyGeneratedVer4)<<12)) &
+		subtle.ConstantTimeEq(int32(id.LeastSigBits>>62), 2)
+
+	if valid != 1 {
+		return Empty, ErrorInvalidSessionToken
+	}
+	return id, nil
+}
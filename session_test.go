@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionToken(t *testing.T) {
+
+	for i := 0; i < 100; i++ {
+
+		id := fixtureID()
+		token := id.SessionToken()
+		assert.Len(t, token, 22)
+
+		cookie := &http.Cookie{Name: "session", Value: token}
+		assert.Equal(t, "session="+token, cookie.String())
+
+		actual, err := uuid.ParseSessionToken(token)
+		assert.NoError(t, err)
+		assert.True(t, id.Equal(actual))
+	}
+
+	id := fixtureID()
+	token := id.SessionToken()
+
+	// not canonical last character
+	last := strings.IndexByte("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_", token[21])
+	_, err := uuid.ParseSessionToken(token[:21] + string("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"[last+1]))
+	assert.Equal(t, uuid.ErrorInvalidSessionToken, err)
+
+	for _, s := range []string{"", token + "A", token[:21] + "=", token[:21] + "+", strings.Repeat("A", 1024)} {
+		_, err := uuid.ParseSessionToken(s)
+		assert.Equal(t, uuid.ErrorInvalidSessionToken, err, s)
+	}
+
+	// only version 4
+	v7 := uuid.MustParse("017f22e2-79b0-7cc3-98c4-dc0c0c07398f")
+	_, err = uuid.ParseSessionToken(v7.SessionToken())
+	assert.Equal(t, uuid.ErrorInvalidSessionToken, err)
+
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+const (
+	DefaultTagLen = 16
+	minTagLen     = 8
+)
+
+var (
+	ErrorInvalidToken     = errors.New("invalid token")
+	ErrorInvalidSignature = errors.New("invalid token signature")
+)
+
+/**
+	Signs UUIDs with truncated HMAC-SHA256 to make unguessable and tamper-proof tokens
+
+    Token is base64url(uuid || tag) without padding
+ */
+
+type Signer struct {
+	key    []byte
+	tagLen int
+}
+
+/**
+	Creates signer with the secret key and tag length in bytes, DefaultTagLen if zero
+
+    Tag length must be in range [8, 32]
+ */
+
+func NewSigner(key []byte, tagLen int) (*Signer, error) {
+
+	if len(key) == 0 {
+		return nil, errors.New("empty signer key")
+	}
+
+	if tagLen == 0 {
+		tagLen = DefaultTagLen
+	}
+
+	if tagLen < minTagLen || tagLen > sha256.Size {
+		return nil, errors.Errorf("tag length %d is out of range [%d, %d]", tagLen, minTagLen, sha256.Size)
+	}
+
+	return &Signer{key: append([]byte(nil), key...), tagLen: tagLen}, nil
+}
+
+/**
+	Produces token for the UUID
+ */
+
+func (this *Signer) Sign(id UUID) string {
+	data := make([]byte, 16, 16+sha256.Size)
+	id.MarshalBinaryTo(data)
+	data = append(data, this.tag(data)...)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+/**
+	Verifies the token signature and returns the signed UUID
+
+    Decoding is strict, tokens differing only in the unused low bits of the last character are rejected.
+ */
+
+func (this *Signer) Verify(token string) (UUID, error) {
+
+	if len(token) != base64.RawURLEncoding.EncodedLen(16+this.tagLen) {
+		return Empty, ErrorInvalidToken
+	}
+
+	data, err := base64.RawURLEncoding.Strict().DecodeString(token)
+	if err != nil {
+		return Empty, ErrorInvalidToken
+	}
+
+	if !hmac.Equal(data[16:], this.tag(data[:16])) {
+		return Empty, ErrorInvalidSignature
+	}
+
+	var id UUID
+	err = id.UnmarshalBinary(data[:16])
+	return id, err
+}
+
+func (this *Signer) tag(data []byte) []byte {
+	mac := hmac.New(sha256.New, this.key)
+	mac.Write(data[:16])
+	return mac.Sum(nil)[:this.tagLen]
+}
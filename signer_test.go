@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSigner(t *testing.T) {
+
+	_, err := uuid.NewSigner(nil, 0)
+	assert.Error(t, err)
+
+	_, err = uuid.NewSigner([]byte("secret"), 4)
+	assert.Error(t, err)
+
+	signer, err := uuid.NewSigner([]byte("secret"), 0)
+	assert.NoError(t, err)
+
+	id := fixtureID()
+
+	token := signer.Sign(id)
+	assert.Len(t, token, 43)
+
+	actual, err := signer.Verify(token)
+	assert.NoError(t, err)
+	assert.True(t, id.Equal(actual))
+
+	// tampered uuid part
+	tampered := []byte(token)
+	if tampered[0] == 'A' {
+		tampered[0] = 'B'
+	} else {
+		tampered[0] = 'A'
+	}
+	_, err = signer.Verify(string(tampered))
+	assert.Equal(t, uuid.ErrorInvalidSignature, err)
+
+	// unused low bits of the last character
+	const alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+	malleable := []byte(token)
+	last := strings.IndexByte(alphabet, malleable[len(malleable)-1])
+	malleable[len(malleable)-1] = alphabet[last^1]
+	_, err = signer.Verify(string(malleable))
+	assert.Equal(t, uuid.ErrorInvalidToken, err)
+
+	// other key
+	other, _ := uuid.NewSigner([]byte("other"), 0)
+	_, err = other.Verify(token)
+	assert.Equal(t, uuid.ErrorInvalidSignature, err)
+
+	_, err = signer.Verify(token[1:])
+	assert.Equal(t, uuid.ErrorInvalidToken, err)
+
+	_, err = signer.Verify("!" + token[1:])
+	assert.Equal(t, uuid.ErrorInvalidToken, err)
+
+}
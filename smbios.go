@@ -0,0 +1,155 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"strings"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Files of Linux sysfs exposing SMBIOS, the first one is formatted by the kernel and readable only by root
+ */
+
+const (
+	DefaultSysfsProductUUID      = "/sys/class/dmi/id/product_uuid"
+	DefaultSysfsSystemEntry      = "/sys/firmware/dmi/entries/1-0/raw"
+	DefaultSysfsSMBIOSEntryPoint = "/sys/firmware/dmi/tables/smbios_entry_point"
+)
+
+var ErrorNoSystemUUID = errors.New("system UUID is not present or not set")
+
+/**
+	Converts 16 bytes of the UUID field of SMBIOS System Information (type 1) structure to UUID
+
+    Since SMBIOS 2.6 the first three fields are stored in little-endian order like in System.Guid,
+    earlier versions store all bytes in network order
+ */
+
+func FromSMBIOS(raw []byte, major, minor int) (UUID, error) {
+	if len(raw) != 16 {
+		return Empty, ErrorWrongLen
+	}
+	if smbiosMixedEndian(major, minor) {
+		return FromLegacyMongo(raw, MongoCSharpLegacy)
+	}
+	var id UUID
+	err := id.UnmarshalBinary(raw)
+	return id, err
+}
+
+/**
+	Stores UUID in to 16 bytes of the SMBIOS UUID field of the version
+ */
+
+func ToSMBIOS(id UUID, major, minor int) []byte {
+	if smbiosMixedEndian(major, minor) {
+		raw, _ := ToLegacyMongo(id, MongoCSharpLegacy)
+		return raw
+	}
+	raw, _ := id.MarshalBinary()
+	return raw
+}
+
+func smbiosMixedEndian(major, minor int) bool {
+	return major > 2 || (major == 2 && minor >= 6)
+}
+
+/**
+	Reads the machine's SMBIOS system UUID for inventory and licensing tools
+
+    Linux reads sysfs, Windows queries WMI Win32_ComputerSystemProduct, macOS reads IOPlatformUUID of IOKit.
+    Returns ErrorNoSystemUUID for the all-zero and all-one values the firmware uses for a missing UUID.
+ */
+
+func ReadSystemUUID(ctx context.Context) (UUID, error) {
+	id, err := readSystemUUID(ctx)
+	if err != nil {
+		return Empty, err
+	}
+	return checkSystemUUID(id)
+}
+
+/**
+	Reads system UUID from Linux sysfs files, the text product UUID first, then the raw type 1 structure
+    with the version of the entry point, empty names are skipped
+ */
+
+func SystemUUIDFromSysfs(productUUID, systemEntry, entryPoint string) (UUID, error) {
+
+	var errs []string
+
+	if productUUID != "" {
+		data, err := os.ReadFile(productUUID)
+		if err == nil {
+			id, err := Parse(strings.TrimSpace(string(data)))
+			if err == nil {
+				return checkSystemUUID(id)
+			}
+			errs = append(errs, err.Error())
+		} else {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	if systemEntry != "" && entryPoint != "" {
+		id, err := readSMBIOSEntry(systemEntry, entryPoint)
+		if err == nil {
+			return checkSystemUUID(id)
+		}
+		errs = append(errs, err.Error())
+	}
+
+	return Empty, errors.Errorf("read system UUID: %s", strings.Join(errs, "; "))
+}
+
+func readSMBIOSEntry(systemEntry, entryPoint string) (UUID, error) {
+
+	ep, err := os.ReadFile(entryPoint)
+	if err != nil {
+		return Empty, err
+	}
+	major, minor, err := parseSMBIOSEntryPoint(ep)
+	if err != nil {
+		return Empty, err
+	}
+
+	structure, err := os.ReadFile(systemEntry)
+	if err != nil {
+		return Empty, err
+	}
+	// type 1 structure: type, length, handle, 4 string indexes, UUID at offset 8 since SMBIOS 2.1
+	if len(structure) < 0x18 || structure[0] != 1 || int(structure[1]) < 0x19 {
+		return Empty, errors.New("malformed SMBIOS system information structure")
+	}
+	return FromSMBIOS(structure[8:24], major, minor)
+}
+
+/**
+	Gets SMBIOS version from the 32-bit _SM_ or 64-bit _SM3_ entry point
+ */
+
+func parseSMBIOSEntryPoint(ep []byte) (major, minor int, err error) {
+	switch {
+	case bytes.HasPrefix(ep, []byte("_SM3_")) && len(ep) >= 9:
+		return int(ep[7]), int(ep[8]), nil
+	case bytes.HasPrefix(ep, []byte("_SM_")) && len(ep) >= 8:
+		return int(ep[6]), int(ep[7]), nil
+	default:
+		return 0, 0, errors.New("unknown SMBIOS entry point")
+	}
+}
+
+func checkSystemUUID(id UUID) (UUID, error) {
+	if id == Empty || id == Max {
+		return Empty, ErrorNoSystemUUID
+	}
+	return id, nil
+}
@@ -0,0 +1,28 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"context"
+	"os/exec"
+	"regexp"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+var platformUUIDPattern = regexp.MustCompile(`"IOPlatformUUID"\s*=\s*"([0-9A-Fa-f-]{36})"`)
+
+func readSystemUUID(ctx context.Context) (UUID, error) {
+	out, err := exec.CommandContext(ctx, "ioreg", "-rd1", "-c", "IOPlatformExpertDevice").Output()
+	if err != nil {
+		return Empty, errors.Wrap(err, "query IOPlatformExpertDevice")
+	}
+	m := platformUUIDPattern.FindSubmatch(out)
+	if m == nil {
+		return Empty, errors.New("IOPlatformUUID not found")
+	}
+	return ParseBytes(m[1])
+}
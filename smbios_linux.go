@@ -0,0 +1,12 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import "context"
+
+func readSystemUUID(ctx context.Context) (UUID, error) {
+	return SystemUUIDFromSysfs(DefaultSysfsProductUUID, DefaultSysfsSystemEntry, DefaultSysfsSMBIOSEntryPoint)
+}
@@ -0,0 +1,19 @@
+//go:build !linux && !windows && !darwin
+// +build !linux,!windows,!darwin
+
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"context"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+func readSystemUUID(ctx context.Context) (UUID, error) {
+	return Empty, errors.New("system UUID is not supported on this platform")
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSMBIOS(t *testing.T) {
+
+	raw := []byte{0x33, 0x22, 0x11, 0x00, 0x55, 0x44, 0x77, 0x66, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff}
+
+	id, err := uuid.FromSMBIOS(raw, 2, 6)
+	assert.NoError(t, err)
+	assert.Equal(t, "00112233-4455-6677-8899-aabbccddeeff", id.String())
+	assert.Equal(t, raw, uuid.ToSMBIOS(id, 3, 0))
+
+	legacy, err := uuid.FromSMBIOS(raw, 2, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, "33221100-5544-7766-8899-aabbccddeeff", legacy.String())
+	assert.Equal(t, raw, uuid.ToSMBIOS(legacy, 2, 5))
+
+	_, err = uuid.FromSMBIOS(raw[:15], 3, 0)
+	assert.Equal(t, uuid.ErrorWrongLen, err)
+
+	dir := t.TempDir()
+	productUUID := filepath.Join(dir, "product_uuid")
+	systemEntry := filepath.Join(dir, "raw")
+	entryPoint := filepath.Join(dir, "smbios_entry_point")
+
+	structure := append([]byte{1, 0x1b, 0x01, 0x00, 1, 2, 3, 4}, raw...)
+	structure = append(structure, 6, 0, 0, 'x', 0, 0)
+	assert.NoError(t, os.WriteFile(systemEntry, structure, 0644))
+	assert.NoError(t, os.WriteFile(entryPoint, []byte("_SM3_\x00\x18\x03\x02\x00"), 0644))
+
+	// product_uuid is missing or not readable by the user
+	id, err = uuid.SystemUUIDFromSysfs(productUUID, systemEntry, entryPoint)
+	assert.NoError(t, err)
+	assert.Equal(t, "00112233-4455-6677-8899-aabbccddeeff", id.String())
+
+	assert.NoError(t, os.WriteFile(productUUID, []byte("4C4C4544-0042-3510-8054-B4C04F564433\n"), 0600))
+	id, err = uuid.SystemUUIDFromSysfs(productUUID, systemEntry, entryPoint)
+	assert.NoError(t, err)
+	assert.Equal(t, "4c4c4544-0042-3510-8054-b4c04f564433", id.String())
+
+	assert.NoError(t, os.WriteFile(productUUID, []byte("FFFFFFFF-FFFF-FFFF-FFFF-FFFFFFFFFFFF\n"), 0600))
+	_, err = uuid.SystemUUIDFromSysfs(productUUID, "", "")
+	assert.Equal(t, uuid.ErrorNoSystemUUID, err)
+
+	assert.NoError(t, os.WriteFile(entryPoint, []byte("_DMI_"), 0644))
+	_, err = uuid.SystemUUIDFromSysfs("", systemEntry, entryPoint)
+	assert.Error(t, err)
+
+	_, err = uuid.SystemUUIDFromSysfs(filepath.Join(dir, "missing"), "", "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,27 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	WMI returns the UUID already converted from the mixed-endian SMBIOS field
+ */
+
+func readSystemUUID(ctx context.Context) (UUID, error) {
+	out, err := exec.CommandContext(ctx, "powershell", "-NoProfile", "-NonInteractive", "-Command",
+		"(Get-CimInstance -ClassName Win32_ComputerSystemProduct).UUID").Output()
+	if err != nil {
+		return Empty, errors.Wrap(err, "query Win32_ComputerSystemProduct")
+	}
+	return Parse(strings.TrimSpace(string(out)))
+}
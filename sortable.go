@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import "github.com/codeallergy/uuid/internal/errors"
+
+/**
+	Header byte of the versioned sortable binary format
+
+    Keys with different tags are ordered by the tag first
+ */
+
+type SortableTag byte
+
+const (
+
+	/**
+		Legacy MarshalSortableBinary layout of the version 1, timestamp blocks flipped and counter converted to unsigned bytes
+	 */
+
+	SortableTagFlipped = SortableTag(0x01)
+
+	/**
+		Plain big-endian bytes of the versions already ordered by time, like version 6 and 7
+	 */
+
+	SortableTagRaw = SortableTag(0x02)
+
+	sortableV2Len = 17
+)
+
+var ErrorUnknownSortableTag = errors.New("unknown sortable binary tag")
+
+/**
+	Stores UUID in to 17 bytes of the self-describing sortable format
+
+    Result is the one-byte SortableTag followed by 16 bytes of the layout selected by the version
+ */
+
+func (this UUID) MarshalSortableBinaryV2() ([]byte, error) {
+	dst := make([]byte, sortableV2Len)
+	err := this.MarshalSortableBinaryV2To(dst)
+	return dst, err
+}
+
+/**
+	Stores UUID in to the slice in the self-describing sortable format
+
+    Supported only for Time-based versions 1, 6 and 7
+ */
+
+func (this UUID) MarshalSortableBinaryV2To(dst []byte) error {
+
+	if len(dst) < sortableV2Len {
+		return ErrorWrongLen
+	}
+
+	switch this.Version() {
+	case TimebasedVer1:
+		dst[0] = byte(SortableTagFlipped)
+		return this.MarshalSortableBinaryTo(dst[1:])
+	case ReorderedTimebasedVer6, TimebasedVer7:
+		dst[0] = byte(SortableTagRaw)
+		return this.MarshalBinaryTo(dst[1:])
+	default:
+		return ErrorRequiredTimebasedUUID
+	}
+}
+
+/**
+	Convert 17 bytes of the self-describing sortable format to UUID
+ */
+
+func (this *UUID) UnmarshalSortableBinaryV2(data []byte) error {
+
+	if len(data) < sortableV2Len {
+		return ErrorWrongLen
+	}
+
+	switch SortableTag(data[0]) {
+	case SortableTagFlipped:
+		return this.UnmarshalSortableBinary(data[1:])
+	case SortableTagRaw:
+		return this.UnmarshalBinary(data[1:])
+	default:
+		return ErrorUnknownSortableTag
+	}
+}
+
+/**
+	Convert either legacy 16 bytes or 17 bytes of the self-describing sortable format to UUID
+
+    Used to read keys stored before and after the migration to the self-describing format
+ */
+
+func (this *UUID) UnmarshalAnySortableBinary(data []byte) error {
+	switch len(data) {
+	case 16:
+		return this.UnmarshalSortableBinary(data)
+	case sortableV2Len:
+		return this.UnmarshalSortableBinaryV2(data)
+	default:
+		return ErrorWrongLen
+	}
+}
+
+/**
+	Converts legacy 16 bytes sortable key to the self-describing format
+
+    Keys already in the self-describing format are returned as is
+ */
+
+func MigrateSortableBinary(data []byte) ([]byte, error) {
+	var id UUID
+	if err := id.UnmarshalAnySortableBinary(data); err != nil {
+		return nil, err
+	}
+	return id.MarshalSortableBinaryV2()
+}
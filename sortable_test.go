@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortableBinaryV2(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	gen, err := uuid.NewTimeGenerator(uuid.TimebasedVer1)
+	assert.NoError(t, err)
+
+	id, _ := gen.Next()
+
+	data, err := id.MarshalSortableBinaryV2()
+	assert.NoError(t, err)
+	assert.Len(t, data, 17)
+	assert.Equal(t, byte(uuid.SortableTagFlipped), data[0])
+
+	legacy, _ := id.MarshalSortableBinary()
+	assert.Equal(t, legacy, data[1:])
+
+	var actual uuid.UUID
+	assert.NoError(t, actual.UnmarshalSortableBinaryV2(data))
+	assert.True(t, id.Equal(actual))
+
+	// reads both layouts
+	assert.NoError(t, actual.UnmarshalAnySortableBinary(legacy))
+	assert.True(t, id.Equal(actual))
+	assert.NoError(t, actual.UnmarshalAnySortableBinary(data))
+	assert.True(t, id.Equal(actual))
+
+	// migration
+	migrated, err := uuid.MigrateSortableBinary(legacy)
+	assert.NoError(t, err)
+	assert.Equal(t, data, migrated)
+
+	migrated, err = uuid.MigrateSortableBinary(data)
+	assert.NoError(t, err)
+	assert.Equal(t, data, migrated)
+
+	// v7 is stored as is and stays ordered
+	prev, _ := uuid.NewV7()
+	prevData, _ := prev.MarshalSortableBinaryV2()
+	assert.Equal(t, byte(uuid.SortableTagRaw), prevData[0])
+	for i := 0; i < 100; i++ {
+		next, _ := uuid.NewV7()
+		nextData, err := next.MarshalSortableBinaryV2()
+		assert.NoError(t, err)
+		assert.True(t, bytes.Compare(prevData, nextData) < 0)
+		assert.NoError(t, actual.UnmarshalSortableBinaryV2(nextData))
+		assert.True(t, next.Equal(actual))
+		prevData = nextData
+	}
+
+	random, _ := uuid.RandomUUID()
+	_, err = random.MarshalSortableBinaryV2()
+	assert.Equal(t, uuid.ErrorRequiredTimebasedUUID, err)
+
+	data[0] = 0x7F
+	assert.Equal(t, uuid.ErrorUnknownSortableTag, actual.UnmarshalSortableBinaryV2(data))
+	assert.Equal(t, uuid.ErrorWrongLen, actual.UnmarshalAnySortableBinary(data[:10]))
+
+}
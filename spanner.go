@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"encoding/base64"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Encodes UUID for Spanner STRING column as canonical string
+
+    EncodeSpanner implements the spanner.Encoder interface of cloud.google.com/go/spanner.
+ */
+
+func (this UUID) EncodeSpanner() (interface{}, error) {
+	return this.String(), nil
+}
+
+/**
+	Decodes UUID from Spanner STRING or BYTES column, NULL fails with ErrorNullUUID like Scan
+
+    DecodeSpanner implements the spanner.Decoder interface of cloud.google.com/go/spanner.
+ */
+
+func (this *UUID) DecodeSpanner(input interface{}) error {
+	switch value := input.(type) {
+	case nil:
+		return ErrorNullUUID
+	case string:
+		return this.Scan(value)
+	case *string:
+		if value == nil {
+			return ErrorNullUUID
+		}
+		return this.Scan(*value)
+	case []byte:
+		return this.Scan(value)
+	default:
+		return errors.Errorf("unsupported Spanner UUID source type %T", input)
+	}
+}
+
+/**
+	Encodes UUID for Spanner BYTES column as 16 bytes
+
+    EncodeSpanner implements the spanner.Encoder interface of cloud.google.com/go/spanner.
+ */
+
+func (this BlobUUID) EncodeSpanner() (interface{}, error) {
+	return UUID(this).MarshalBinary()
+}
+
+/**
+	Decodes UUID from Spanner BYTES or STRING column, NULL fails with ErrorNullUUID
+
+    Spanner delivers BYTES as the base64 string, 24 characters for 16 bytes, other strings are parsed as STRING column.
+    DecodeSpanner implements the spanner.Decoder interface of cloud.google.com/go/spanner.
+ */
+
+func (this *BlobUUID) DecodeSpanner(input interface{}) error {
+	switch value := input.(type) {
+	case string:
+		return this.decodeSpannerString(value)
+	case *string:
+		if value == nil {
+			return ErrorNullUUID
+		}
+		return this.decodeSpannerString(*value)
+	default:
+		return (*UUID)(this).DecodeSpanner(input)
+	}
+}
+
+func (this *BlobUUID) decodeSpannerString(s string) error {
+	if len(s) != base64.StdEncoding.EncodedLen(16) {
+		return (*UUID)(this).Scan(s)
+	}
+	id, err := ParseBase64(s)
+	if err != nil {
+		return err
+	}
+	*this = BlobUUID(id)
+	return nil
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpanner(t *testing.T) {
+
+	id, _ := uuid.Parse("534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+	blob, _ := id.MarshalBinary()
+
+	value, err := id.EncodeSpanner()
+	assert.NoError(t, err)
+	assert.Equal(t, id.String(), value)
+
+	value, err = uuid.BlobUUID(id).EncodeSpanner()
+	assert.NoError(t, err)
+	assert.Equal(t, blob, value)
+
+	var actual uuid.UUID
+	assert.NoError(t, actual.DecodeSpanner(id.String()))
+	assert.True(t, id.Equal(actual))
+
+	actual = uuid.Empty
+	assert.NoError(t, actual.DecodeSpanner(blob))
+	assert.True(t, id.Equal(actual))
+
+	s := id.String()
+	var scanned uuid.BlobUUID
+	assert.NoError(t, scanned.DecodeSpanner(&s))
+	assert.True(t, id.Equal(uuid.UUID(scanned)))
+
+	// NULL
+	assert.Equal(t, uuid.ErrorNullUUID, actual.DecodeSpanner((*string)(nil)))
+	assert.Equal(t, uuid.ErrorNullUUID, actual.DecodeSpanner(nil))
+	assert.Equal(t, uuid.ErrorNullUUID, scanned.DecodeSpanner((*string)(nil)))
+
+	// BYTES column arrives as base64 string
+	scanned = uuid.BlobUUID{}
+	assert.NoError(t, scanned.DecodeSpanner("U0tEoZvxPSC3HsxOt3xXLw=="))
+	assert.True(t, id.Equal(uuid.UUID(scanned)))
+	encoded := id.Base64()
+	scanned = uuid.BlobUUID{}
+	assert.NoError(t, scanned.DecodeSpanner(&encoded))
+	assert.True(t, id.Equal(uuid.UUID(scanned)))
+	scanned = uuid.BlobUUID{}
+	assert.NoError(t, scanned.DecodeSpanner(blob))
+	assert.True(t, id.Equal(uuid.UUID(scanned)))
+	assert.Error(t, scanned.DecodeSpanner("U0tEoZvxPSC3HsxOt3xX!!=="))
+
+	assert.Error(t, actual.DecodeSpanner(42))
+
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"database/sql/driver"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+var ErrorNullUUID = errors.New("NULL scanned into UUID, use NullUUID for nullable columns")
+
+/**
+	Scan implements the sql.Scanner interface
+
+    Accepts both TEXT (any format supported by Parse) and 16 bytes BLOB storage,
+    NULL fails with ErrorNullUUID, nullable columns are scanned into NullUUID
+ */
+
+func (this *UUID) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case nil:
+		return ErrorNullUUID
+	case string:
+		id, err := Parse(value)
+		if err != nil {
+			return err
+		}
+		*this = id
+		return nil
+	case []byte:
+		if len(value) == 16 {
+			return this.UnmarshalBinary(value)
+		}
+		id, err := ParseBytes(value)
+		if err != nil {
+			return err
+		}
+		*this = id
+		return nil
+	default:
+		return errors.Errorf("unsupported UUID source type %T", src)
+	}
+}
+
+/**
+	Value implements the driver.Valuer interface, writes canonical TEXT
+ */
+
+func (this UUID) Value() (driver.Value, error) {
+	return this.String(), nil
+}
+
+/**
+	UUID written as 16 bytes BLOB, e.g. db.Exec(query, uuid.BlobUUID(id))
+
+    Scan accepts both TEXT and BLOB like UUID.Scan
+ */
+
+type BlobUUID UUID
+
+/**
+	Value implements the driver.Valuer interface, writes 16 bytes BLOB
+ */
+
+func (this BlobUUID) Value() (driver.Value, error) {
+	return UUID(this).MarshalBinary()
+}
+
+/**
+	Scan implements the sql.Scanner interface
+ */
+
+func (this *BlobUUID) Scan(src interface{}) error {
+	return (*UUID)(this).Scan(src)
+}
+
+/**
+	UUID that may be NULL, works like sql.NullString
+
+    Scan accepts NULL, TEXT and BLOB, Value writes NULL or canonical TEXT
+ */
+
+type NullUUID struct {
+	UUID  UUID
+	Valid bool
+}
+
+/**
+	Scan implements the sql.Scanner interface
+ */
+
+func (this *NullUUID) Scan(src interface{}) error {
+	if src == nil {
+		this.UUID, this.Valid = Empty, false
+		return nil
+	}
+	if err := this.UUID.Scan(src); err != nil {
+		this.Valid = false
+		return err
+	}
+	this.Valid = true
+	return nil
+}
+
+/**
+	Value implements the driver.Valuer interface, writes NULL if not valid
+ */
+
+func (this NullUUID) Value() (driver.Value, error) {
+	if !this.Valid {
+		return nil, nil
+	}
+	return this.UUID.Value()
+}
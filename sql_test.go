@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSQL(t *testing.T) {
+
+	id, _ := uuid.Parse("534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+	blob, _ := id.MarshalBinary()
+
+	var actual uuid.UUID
+	assert.NoError(t, actual.Scan("534b44a1-9bf1-3d20-b71e-cc4eb77c572f"))
+	assert.True(t, id.Equal(actual))
+
+	actual = uuid.Empty
+	assert.NoError(t, actual.Scan([]byte("534b44a1-9bf1-3d20-b71e-cc4eb77c572f")))
+	assert.True(t, id.Equal(actual))
+
+	actual = uuid.Empty
+	assert.NoError(t, actual.Scan(blob))
+	assert.True(t, id.Equal(actual))
+
+	assert.Equal(t, uuid.ErrorNullUUID, actual.Scan(nil))
+
+	assert.Error(t, actual.Scan(42))
+	assert.Error(t, actual.Scan("42"))
+	assert.Error(t, actual.Scan([]byte{1, 2, 3}))
+
+	value, err := id.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "534b44a1-9bf1-3d20-b71e-cc4eb77c572f", value)
+
+	value, err = uuid.BlobUUID(id).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, blob, value)
+
+	var scanned uuid.BlobUUID
+	assert.NoError(t, scanned.Scan(blob))
+	assert.True(t, id.Equal(uuid.UUID(scanned)))
+
+	var null uuid.NullUUID
+	assert.NoError(t, null.Scan(blob))
+	assert.True(t, null.Valid)
+	assert.True(t, id.Equal(null.UUID))
+	value, err = null.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "534b44a1-9bf1-3d20-b71e-cc4eb77c572f", value)
+
+	assert.NoError(t, null.Scan(nil))
+	assert.False(t, null.Valid)
+	assert.True(t, uuid.Empty.Equal(null.UUID))
+	value, err = null.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+
+	assert.Error(t, null.Scan(42))
+	assert.False(t, null.Valid)
+
+}
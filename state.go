@@ -0,0 +1,262 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Generator that can hand off its state to the next process generation, e.g. in blue/green deployments
+
+    The state restored from the previous generator keeps UUIDs of the next one increasing,
+    the previous generator must stop minting before the state is saved.
+ */
+
+type StatefulGenerator interface {
+	Generator
+
+	/**
+		Gets snapshot of the last timestamp, clock sequence, counters and node
+	 */
+
+	SaveState() ([]byte, error)
+
+	/**
+		Continues after the snapshot, timestamps never move backwards
+	 */
+
+	RestoreState(data []byte) error
+}
+
+/**
+	Interval of timestamps between the rewrites of the state file given to GeneratorConfig
+ */
+
+const stateSaveInterval = 10 * time.Second
+
+/**
+	Persistent state of the time-based generator
+ */
+
+type generatorState struct {
+	Version       Version `json:"version"`
+	Node          int64   `json:"node"`
+	ClockSequence int     `json:"clockSequence"`
+	LastTime      int64   `json:"lastTime"`
+	Counter       uint64  `json:"counter"`
+
+	Precision TimestampPrecision `json:"precision,omitempty"`
+}
+
+/**
+	Persistent state of the HLC generator
+ */
+
+type hlcState struct {
+	Wall    int64  `json:"wall"`
+	Logical uint64 `json:"logical"`
+}
+
+/**
+	Gets JSON snapshot of the generator state
+
+    SaveState implements the StatefulGenerator interface.
+ */
+
+func (this *TimeGenerator) SaveState() ([]byte, error) {
+	this.Lock()
+	defer this.Unlock()
+	return this.saveState()
+}
+
+/**
+	Restores generator state from the JSON snapshot of the same version and precision
+
+    RestoreState implements the StatefulGenerator interface.
+ */
+
+func (this *TimeGenerator) RestoreState(data []byte) error {
+
+	var state generatorState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return errors.Wrap(err, "parse generator state")
+	}
+
+	this.Lock()
+	defer this.Unlock()
+
+	if state.Version != this.version || state.Precision != this.precision {
+		return errors.Errorf("generator state of %v %v does not match %v %v", state.Version, state.Precision, this.version, this.precision)
+	}
+
+	this.node = state.Node & nodeMask
+	this.clockSequence = state.ClockSequence & clockSequenceBits
+	this.initialClockSequence = this.clockSequence
+	this.restoreTime(state)
+	return nil
+}
+
+func (this *TimeGenerator) saveState() ([]byte, error) {
+	return json.Marshal(generatorState{
+		Version:       this.version,
+		Node:          this.node,
+		ClockSequence: this.clockSequence,
+		LastTime:      this.lastTime,
+		Counter:       this.counter,
+		Precision:     this.precision,
+	})
+}
+
+/**
+	Moves last timestamp and counter forward to the state, never backwards
+ */
+
+func (this *TimeGenerator) restoreTime(state generatorState) {
+	if state.LastTime > this.lastTime || (state.LastTime == this.lastTime && state.Counter > this.counter) {
+		this.lastTime = state.LastTime
+		this.counter = state.Counter
+	}
+}
+
+/**
+	Restores state from the file and writes it back with incremented clock sequence
+
+    Unlike RestoreState the clock sequence is incremented, because the previous process could be stopped
+    without saving its last timestamp. The node is restored only if restoreNode is set, e.g. the random node,
+    otherwise the configured node is kept. The generator holding a coordinator Lease increments
+    the clock sequence within its slot.
+ */
+
+func (this *TimeGenerator) syncStateFile(path string, restoreNode bool) error {
+
+	this.Lock()
+	defer this.Unlock()
+
+	data, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		var state generatorState
+		if err := json.Unmarshal(data, &state); err != nil {
+			return errors.Wrapf(err, "parse state file '%s'", path)
+		}
+		if state.Version == this.version && state.Precision == this.precision {
+			if restoreNode && state.Node != 0 {
+				this.node = state.Node & nodeMask
+			}
+			if this.lease != nil {
+				this.clockSequence = this.lease.advance(state.ClockSequence)
+			} else {
+				this.clockSequence = (state.ClockSequence + 1) & clockSequenceBits
+			}
+			this.initialClockSequence = this.clockSequence
+			this.restoreTime(state)
+		}
+	case os.IsNotExist(err):
+	default:
+		return errors.Wrapf(err, "read state file '%s'", path)
+	}
+
+	data, err = this.saveState()
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomic(path, data, 0644); err != nil {
+		return err
+	}
+	this.stateFile, this.stateSaved = path, this.lastTime
+	return nil
+}
+
+/**
+	Rewrites the state file once timestamps moved stateSaveInterval past the saved one,
+	a failed write is retried after the next interval and reported by Healthy until it succeeds
+ */
+
+func (this *TimeGenerator) persist() {
+	if this.stateFile == "" || this.clockDuration(this.lastTime-this.stateSaved) < stateSaveInterval {
+		return
+	}
+	this.stateSaved = this.lastTime
+	data, err := this.saveState()
+	if err == nil {
+		err = writeFileAtomic(this.stateFile, data, 0644)
+	}
+	this.stateErr = err
+}
+
+/**
+	Writes the file via the temporary file in the same directory and rename,
+	so readers see either the old or the new content even if the process crashes
+ */
+
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return errors.Wrapf(err, "create temp file for '%s'", path)
+	}
+	tmp := f.Name()
+
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp, perm)
+	}
+	if err == nil {
+		err = os.Rename(tmp, path)
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return errors.Wrapf(err, "write state file '%s'", path)
+	}
+	return nil
+}
+
+/**
+	Gets JSON snapshot of the HLC
+
+    SaveState implements the StatefulGenerator interface.
+ */
+
+func (this *HLCGenerator) SaveState() ([]byte, error) {
+	this.Lock()
+	defer this.Unlock()
+	return json.Marshal(hlcState{Wall: this.wall, Logical: this.logical})
+}
+
+/**
+	Restores HLC from the JSON snapshot, the clock never moves backwards
+
+    RestoreState implements the StatefulGenerator interface.
+ */
+
+func (this *HLCGenerator) RestoreState(data []byte) error {
+
+	var state hlcState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return errors.Wrap(err, "parse generator state")
+	}
+
+	this.Lock()
+	defer this.Unlock()
+
+	if state.Wall > this.wall || (state.Wall == this.wall && state.Logical > this.logical) {
+		this.wall = state.Wall
+		this.logical = state.Logical & hlcLogicalMask
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSaveRestoreState(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	now := time.Now()
+
+	for _, version := range []uuid.Version{uuid.TimebasedVer1, uuid.TimebasedVer7} {
+
+		blue, err := uuid.NewTimeGenerator(version)
+		assert.NoError(t, err)
+		blue.SetClock(func() time.Time { return now })
+
+		var last uuid.UUID
+		for i := 0; i < 100; i++ {
+			last, err = blue.Next()
+			assert.NoError(t, err)
+		}
+
+		state, err := blue.SaveState()
+		assert.NoError(t, err)
+
+		green, err := uuid.NewTimeGenerator(version)
+		assert.NoError(t, err)
+		green.SetClock(func() time.Time { return now.Add(-time.Second) })
+
+		var stateful uuid.StatefulGenerator = green
+		assert.NoError(t, stateful.RestoreState(state))
+		assert.Equal(t, blue.Node(), green.Node())
+		assert.Equal(t, blue.ClockSequence(), green.ClockSequence())
+
+		next, err := green.Next()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, uuid.CompareTimeFirst(next, last), version)
+
+		other, err := uuid.NewTimeGenerator(uuid.TimebasedVer1 + uuid.TimebasedVer7 - version)
+		assert.NoError(t, err)
+		assert.Error(t, other.RestoreState(state))
+		assert.Error(t, green.RestoreState([]byte("{")))
+	}
+
+	blue := uuid.NewHLCGenerator()
+	blue.SetClock(func() time.Time { return now })
+	last, _ := blue.Next()
+	last, _ = blue.Next()
+	state, err := blue.SaveState()
+	assert.NoError(t, err)
+
+	green := uuid.NewHLCGenerator()
+	green.SetClock(func() time.Time { return now.Add(-time.Second) })
+	assert.NoError(t, green.RestoreState(state))
+	next, _ := green.Next()
+	assert.Equal(t, 1, uuid.ComparePostgres(next, last))
+
+}
@@ -0,0 +1,200 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Layout of version 7 UUID minted by StreamAllocator, RFC 9562 section 6.2 method 1
+
+	msb: 48-bit unix_ts_ms + 4-bit version + 12 high bits of the counter
+	lsb: 2-bit variant + 14 low bits of the counter + 48-bit random
+ */
+
+const (
+	streamCounterBits = 26
+	streamCounterMask = uint64(1)<<streamCounterBits - 1
+	streamRandomMask  = uint64(0x0000FFFFFFFFFFFF)
+)
+
+type streamState struct {
+	millis  int64
+	counter uint64
+}
+
+/**
+	Allocator of version 7 UUIDs strictly increasing within every stream key
+
+    Event stores can use the UUID itself as the per-aggregate sequence.
+    Streams are independent, so UUIDs of different streams minted in the same millisecond are not ordered.
+    The counter of the stream starts at random and increments by one across milliseconds,
+    when it wraps the next UUID moves to the next millisecond. Consecutive counters let SequenceAuditor
+    detect lost UUIDs, Forget starts the stream over from a random counter.
+ */
+
+type StreamAllocator struct {
+	sync.Mutex
+
+	streams map[string]*streamState
+
+	now    func() time.Time
+	reader io.Reader
+}
+
+/**
+	Creates allocator backed by the wall clock and crypto/rand
+ */
+
+func NewStreamAllocator() *StreamAllocator {
+	return &StreamAllocator{
+		streams: make(map[string]*streamState),
+		now:     time.Now,
+		reader:  rand.Reader,
+	}
+}
+
+/**
+	Sets source of the wall clock, time.Now by default
+ */
+
+func (this *StreamAllocator) SetClock(now func() time.Time) {
+	this.Lock()
+	defer this.Unlock()
+	this.now = now
+}
+
+/**
+	Generates UUID greater than all UUIDs previously generated or resumed for the stream
+ */
+
+func (this *StreamAllocator) Next(stream string) (uuid UUID, err error) {
+
+	if err := checkFrozen(); err != nil {
+		return Empty, err
+	}
+
+	var randomBytes [8]byte
+	if _, err := io.ReadFull(this.reader, randomBytes[:]); err != nil {
+		currentHooks().entropyError(err)
+		return Empty, errors.Wrap(err, "read entropy")
+	}
+	random := binary.BigEndian.Uint64(randomBytes[:])
+
+	this.Lock()
+	defer this.Unlock()
+
+	state, ok := this.streams[stream]
+	var previous streamState
+	if !ok {
+		// start from the lower half to leave room for the increments before the counter wraps
+		state = &streamState{counter: (random >> 48) & (streamCounterMask >> 11)}
+		this.streams[stream] = state
+	} else {
+		previous = *state
+		state.counter = (state.counter + 1) & streamCounterMask
+	}
+
+	millis := this.now().UnixNano() / int64(time.Millisecond)
+	if millis < state.millis || (millis == state.millis && state.counter == 0) {
+		millis = state.millis
+		if state.counter == 0 {
+			millis++
+		}
+	}
+	state.millis = millis
+
+	// only the random bits are re-rolled, so the counter of the stream stays consecutive
+	rolled := false
+	uuid, err = rerollReserved(func() (uuid UUID, err error) {
+		if rolled {
+			if _, err := io.ReadFull(this.reader, randomBytes[:]); err != nil {
+				currentHooks().entropyError(err)
+				return Empty, errors.Wrap(err, "read entropy")
+			}
+			random = binary.BigEndian.Uint64(randomBytes[:])
+		}
+		rolled = true
+		uuid.MostSigBits = (uint64(millis) << 16) | v7VersionBits | (state.counter >> 14)
+		uuid.LeastSigBits = variantIETFBits | (state.counter&0x3FFF)<<48 | (random & streamRandomMask)
+		return uuid, nil
+	})
+	if err != nil {
+		if ok {
+			*state = previous
+		} else {
+			delete(this.streams, stream)
+		}
+		return Empty, err
+	}
+	if err := currentHooks().generated(uuid); err != nil {
+		return Empty, err
+	}
+	return uuid, nil
+}
+
+/**
+	Continues the stream after the last known UUID, e.g. loaded from the event store after restart
+
+    Version 7 UUIDs minted by other generators are accepted as well
+ */
+
+func (this *StreamAllocator) Resume(stream string, last UUID) error {
+
+	if last.Version() != TimebasedVer7 {
+		return errors.Errorf("stream requires version 7 UUID, got %v", last.Version())
+	}
+
+	millis, counter := streamPosition(last)
+
+	this.Lock()
+	defer this.Unlock()
+
+	state, ok := this.streams[stream]
+	if !ok {
+		state = &streamState{}
+		this.streams[stream] = state
+	}
+	if millis > state.millis || (millis == state.millis && counter > state.counter) {
+		state.millis, state.counter = millis, counter
+	}
+	return nil
+}
+
+/**
+	Removes state of the stream, next UUIDs of the stream are ordered only by the clock
+ */
+
+func (this *StreamAllocator) Forget(stream string) {
+	this.Lock()
+	defer this.Unlock()
+	delete(this.streams, stream)
+}
+
+/**
+	Gets number of tracked streams
+ */
+
+func (this *StreamAllocator) Len() int {
+	this.Lock()
+	defer this.Unlock()
+	return len(this.streams)
+}
+
+/**
+	Gets millisecond and counter of UUID minted by StreamAllocator
+ */
+
+func streamPosition(id UUID) (int64, uint64) {
+	return int64(id.MostSigBits >> 16), (id.MostSigBits&v7CounterMask)<<14 | (id.LeastSigBits>>48)&0x3FFF
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamAllocator(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	now := time.Now()
+	alloc := uuid.NewStreamAllocator()
+	alloc.SetClock(func() time.Time { return now })
+
+	prev := map[string]uuid.UUID{}
+	for i := 0; i < 20000; i++ {
+		stream := []string{"order-1", "order-2"}[i%2]
+		id, err := alloc.Next(stream)
+		assert.NoError(t, err)
+		assert.Equal(t, uuid.TimebasedVer7, id.Version())
+		assert.Equal(t, uuid.IETF, id.Variant())
+		if p, ok := prev[stream]; ok && uuid.ComparePostgres(p, id) >= 0 {
+			t.Fatal("not monotonic ", p, id)
+		}
+		prev[stream] = id
+	}
+	assert.Equal(t, 2, alloc.Len())
+
+	restarted := uuid.NewStreamAllocator()
+	now = now.Add(-time.Hour)
+	restarted.SetClock(func() time.Time { return now })
+	assert.NoError(t, restarted.Resume("order-1", prev["order-1"]))
+	id, err := restarted.Next("order-1")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, uuid.ComparePostgres(id, prev["order-1"]))
+
+	restarted.Forget("order-1")
+	assert.Equal(t, 0, restarted.Len())
+
+	assert.Error(t, restarted.Resume("order-1", uuid.New(uuid.RandomlyGeneratedVer4)))
+
+}
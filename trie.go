@@ -0,0 +1,187 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+/**
+	Prefix-match lookup structure over a set of UUIDs keyed by 32 hex digits
+
+    Nodes branch on one hex digit and a subtree with a single UUID is kept as a leaf, so the depth
+    grows only where UUIDs share prefixes. Lookups by prefix visit only the matching subtree,
+    results are ordered like ComparePostgres. Not safe for concurrent modification.
+ */
+
+type Trie struct {
+	root trieNode
+	len  int
+}
+
+type trieNode struct {
+	children *[16]*trieNode
+	id       UUID
+	leaf     bool
+}
+
+/**
+	Creates empty trie
+ */
+
+func NewTrie() *Trie {
+	return &Trie{}
+}
+
+/**
+	Adds UUID, returns false if it is already in the trie
+ */
+
+func (this *Trie) Insert(id UUID) bool {
+
+	node := &this.root
+	for depth := 0; ; depth++ {
+
+		if node.children == nil {
+			if !node.leaf {
+				// empty root
+				node.id, node.leaf = id, true
+				this.len++
+				return true
+			}
+			if node.id == id {
+				return false
+			}
+			// split the leaf
+			existing := node.id
+			node.leaf = false
+			node.children = new([16]*trieNode)
+			node.children[hexDigitAt(existing, depth)] = &trieNode{id: existing, leaf: true}
+		}
+
+		digit := hexDigitAt(id, depth)
+		child := node.children[digit]
+		if child == nil {
+			node.children[digit] = &trieNode{id: id, leaf: true}
+			this.len++
+			return true
+		}
+		node = child
+	}
+}
+
+/**
+	Returns true if the trie contains the UUID
+ */
+
+func (this *Trie) Contains(id UUID) bool {
+	node := &this.root
+	for depth := 0; node != nil; depth++ {
+		if node.children == nil {
+			return node.leaf && node.id == id
+		}
+		node = node.children[hexDigitAt(id, depth)]
+	}
+	return false
+}
+
+/**
+	Gets number of UUIDs
+ */
+
+func (this *Trie) Len() int {
+	return this.len
+}
+
+/**
+	Gets all UUIDs starting with the hex prefix in any case, hyphens are ignored
+ */
+
+func (this *Trie) FindByPrefix(hexPrefix string) ([]UUID, error) {
+	var ids []UUID
+	err := this.Walk(hexPrefix, func(id UUID) bool {
+		ids = append(ids, id)
+		return true
+	})
+	return ids, err
+}
+
+/**
+	Calls the function for UUIDs starting with the hex prefix in order until it returns false,
+    empty prefix visits all UUIDs
+ */
+
+func (this *Trie) Walk(hexPrefix string, fn func(UUID) bool) error {
+
+	node := &this.root
+	if hexPrefix != "" {
+		low, digits, err := parseHexPrefix(hexPrefix)
+		if err != nil {
+			return err
+		}
+		for depth := 0; depth < digits; depth++ {
+			if node.children == nil {
+				if node.leaf && commonHexPrefix(node.id, low) >= digits {
+					fn(node.id)
+				}
+				return nil
+			}
+			if node = node.children[hexDigitAt(low, depth)]; node == nil {
+				return nil
+			}
+		}
+	}
+
+	node.walk(fn)
+	return nil
+}
+
+func (this *trieNode) walk(fn func(UUID) bool) bool {
+	if this.children == nil {
+		return !this.leaf || fn(this.id)
+	}
+	for _, child := range this.children {
+		if child != nil && !child.walk(fn) {
+			return false
+		}
+	}
+	return true
+}
+
+/**
+	Gets number of leading hex digits distinguishing the UUID from all others in the trie, at least 1
+ */
+
+func (this *Trie) UniquePrefixLen(id UUID) int {
+	node := &this.root
+	for depth := 0; ; depth++ {
+		if node.children == nil {
+			n := depth
+			if node.leaf && node.id != id {
+				n = commonHexPrefix(node.id, id) + 1
+			}
+			if n < 1 {
+				n = 1
+			}
+			if n > 32 {
+				n = 32
+			}
+			return n
+		}
+		child := node.children[hexDigitAt(id, depth)]
+		if child == nil {
+			return depth + 1
+		}
+		node = child
+	}
+}
+
+/**
+	Gets hex digit of the UUID at the position from 0 to 31
+ */
+
+func hexDigitAt(id UUID, pos int) int {
+	if pos < 16 {
+		return int(id.MostSigBits>>(60-4*pos)) & 0xF
+	}
+	return int(id.LeastSigBits>>(60-4*(pos-16))) & 0xF
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrie(t *testing.T) {
+
+	trie := uuid.NewTrie()
+
+	var ids []uuid.UUID
+	for i := 0; i < 5000; i++ {
+		id := fixtureID()
+		ids = append(ids, id)
+		assert.True(t, trie.Insert(id))
+	}
+	near := ids[0]
+	near.LeastSigBits ^= 1
+	ids = append(ids, near)
+	assert.True(t, trie.Insert(near))
+	assert.False(t, trie.Insert(ids[0]))
+	assert.Equal(t, 5001, trie.Len())
+
+	for _, id := range ids {
+		assert.True(t, trie.Contains(id))
+	}
+	missing := ids[1]
+	missing.LeastSigBits ^= 1
+	assert.False(t, trie.Contains(missing))
+
+	sort.Slice(ids, func(i, j int) bool { return uuid.ComparePostgres(ids[i], ids[j]) < 0 })
+	all, err := trie.FindByPrefix("")
+	assert.NoError(t, err)
+	assert.Equal(t, ids, all)
+
+	for _, prefix := range []string{"a", "5F", "534b-", ids[100].String()[:6], ids[200].String(), near.Hex()[:31]} {
+		found, err := trie.FindByPrefix(prefix)
+		assert.NoError(t, err)
+		var expected []uuid.UUID
+		p := strings.ToLower(strings.ReplaceAll(prefix, "-", ""))
+		for _, id := range ids {
+			if strings.HasPrefix(id.Hex(), p) {
+				expected = append(expected, id)
+			}
+		}
+		assert.Equal(t, expected, found, prefix)
+	}
+
+	assert.Equal(t, 32, trie.UniquePrefixLen(near))
+
+	count := 0
+	assert.NoError(t, trie.Walk("", func(uuid.UUID) bool {
+		count++
+		return count < 10
+	}))
+	assert.Equal(t, 10, count)
+
+	_, err = trie.FindByPrefix("xyz")
+	assert.Error(t, err)
+
+	empty := uuid.NewTrie()
+	found, err := empty.FindByPrefix("a")
+	assert.NoError(t, err)
+	assert.Empty(t, found)
+	assert.False(t, empty.Contains(near))
+
+	single := uuid.NewTrie()
+	single.Insert(near)
+	assert.Equal(t, 1, single.UniquePrefixLen(near))
+	found, _ = single.FindByPrefix(near.Hex()[:3])
+	assert.Equal(t, []uuid.UUID{near}, found)
+	found, _ = single.FindByPrefix("0" + near.Hex()[1:3])
+	if near.Hex()[0] != '0' {
+		assert.Empty(t, found)
+	}
+}
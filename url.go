@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"net/url"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Gets URL path segment, the canonical string is safe in paths as is
+ */
+
+func (this UUID) EncodePathSegment() string {
+	return this.String()
+}
+
+/**
+	Gets short URL path segment, 22 characters of URL-safe base64
+ */
+
+func (this UUID) EncodeShortPathSegment() string {
+	return this.Base64URL()
+}
+
+/**
+	Parses URL path segment in the canonical or short form, percent-encoding is decoded first
+ */
+
+func DecodePathSegment(segment string) (UUID, error) {
+
+	s, err := url.PathUnescape(segment)
+	if err != nil {
+		return Empty, errors.Wrapf(err, "invalid path segment %q", segment)
+	}
+
+	if len(s) == 22 {
+		return ParseBase64URL(s)
+	}
+	return Parse(s)
+}
+
+/**
+	Sets the canonical string of UUID to URL query values
+ */
+
+func SetUUID(values url.Values, key string, id UUID) {
+	values.Set(key, id.EncodePathSegment())
+}
+
+/**
+	Sets the short form of UUID to URL query values
+ */
+
+func SetShortUUID(values url.Values, key string, id UUID) {
+	values.Set(key, id.EncodeShortPathSegment())
+}
+
+/**
+	Gets UUID from URL query values in the canonical or short form
+ */
+
+func GetUUID(values url.Values, key string) (UUID, error) {
+
+	s := values.Get(key)
+	if s == "" {
+		return Empty, errors.Errorf("missing UUID query parameter %q", key)
+	}
+
+	if len(s) == 22 {
+		return ParseBase64URL(s)
+	}
+	return Parse(s)
+}
@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestURL(t *testing.T) {
+
+	id, _ := uuid.Parse("534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+
+	assert.Equal(t, url.PathEscape(id.EncodePathSegment()), id.EncodePathSegment())
+	assert.Equal(t, url.PathEscape(id.EncodeShortPathSegment()), id.EncodeShortPathSegment())
+
+	for _, segment := range []string{id.EncodePathSegment(), id.EncodeShortPathSegment(), "%7B534b44a1-9bf1-3d20-b71e-cc4eb77c572f%7D"} {
+		actual, err := uuid.DecodePathSegment(segment)
+		assert.NoError(t, err, segment)
+		assert.True(t, id.Equal(actual), segment)
+	}
+
+	_, err := uuid.DecodePathSegment("%zz")
+	assert.Error(t, err)
+
+	values := url.Values{}
+	uuid.SetUUID(values, "id", id)
+	uuid.SetShortUUID(values, "short", id)
+	assert.Equal(t, "id=534b44a1-9bf1-3d20-b71e-cc4eb77c572f&short=U0tEoZvxPSC3HsxOt3xXLw", values.Encode())
+
+	parsed, err := url.ParseQuery(values.Encode())
+	assert.NoError(t, err)
+
+	for _, key := range []string{"id", "short"} {
+		actual, err := uuid.GetUUID(parsed, key)
+		assert.NoError(t, err)
+		assert.True(t, id.Equal(actual))
+	}
+
+	_, err = uuid.GetUUID(parsed, "missing")
+	assert.EqualError(t, err, `missing UUID query parameter "missing"`)
+
+}
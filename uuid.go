@@ -7,7 +7,7 @@ package uuid
 
 import (
 	"crypto/rand"
-	"github.com/pkg/errors"
+	"github.com/codeallergy/uuid/internal/errors"
 	"crypto/md5"
 	"encoding/binary"
 	"encoding/hex"
@@ -84,7 +84,13 @@ const (
 	NamebasedVer3
 	RandomlyGeneratedVer4
 	NamebasedVer5
-	UnknownVersion
+	ReorderedTimebasedVer6
+	TimebasedVer7
+	CustomVer8
+
+	// out of the 4-bit version field, so the value does not move when versions are added;
+	// it was 6 before versions 6, 7 and 8, code persisting Version values must migrate 6 to 16
+	UnknownVersion = Version(16)
 )
 
 /**
@@ -300,16 +306,23 @@ func (this*UUID) UnmarshalSortableBinary(data []byte) error {
 
 func RandomUUID() (uuid UUID, err error) {
 
-	var randomBytes = make([]byte, 16)
-	rand.Read(randomBytes)
+	if err := checkFrozen(); err != nil {
+		return Empty, err
+	}
+
+	return minted(rerollReserved(func() (uuid UUID, err error) {
 
-	randomBytes[6]  &= 0x0f;  /* clear version        */
-	randomBytes[6]  |= 0x40;  /* set to version 4     */
-	randomBytes[8]  &= 0x3f;  /* clear variant        */
-	randomBytes[8]  |= 0x80;  /* set to IETF variant  */
+		var randomBytes = make([]byte, 16)
+		rand.Read(randomBytes)
 
-	err = uuid.UnmarshalBinary(randomBytes)
-	return uuid, err
+		randomBytes[6]  &= 0x0f;  /* clear version        */
+		randomBytes[6]  |= 0x40;  /* set to version 4     */
+		randomBytes[8]  &= 0x3f;  /* clear variant        */
+		randomBytes[8]  |= 0x80;  /* set to IETF variant  */
+
+		err = uuid.UnmarshalBinary(randomBytes)
+		return uuid, err
+	}))
 
 }
 
@@ -368,7 +381,7 @@ func (this UUID) Version() Version {
 
 	version := int((this.MostSigBits & versionMask) >> 12)
 
-	if version >= int(UnknownVersion) {
+	if version > int(CustomVer8) {
 		return UnknownVersion
 	}
 
@@ -481,19 +494,29 @@ func (this*UUID) SetMaxTime() {
 	Gets timestamp in milliseconds from Time-based UUID
 
 	It is measured in millisecond units in unix time since 1 Jan 1970
+
+    For version 7 it is the 48-bit unix_ts_ms field
  */
 
 func (this UUID) UnixTimeMillis() int64 {
+	if this.Version() == TimebasedVer7 {
+		return int64(this.MostSigBits >> 16)
+	}
 	return (this.Time100Nanos() - num100NanosSinceUUIDEpoch) / one100NanosInMillis
 }
 
 /**
 	Sets timestamp in milliseconds to Time-based UUID
 
-    It is measured in millisecond units in unix time since 1 Jan 1970
+    It is measured in millisecond units in unix time since 1 Jan 1970,
+    version 7 UUID keeps rand_a and rand_b
  */
 
 func (this*UUID) SetUnixTimeMillis(unixTimeMillis int64) {
+	if this.Version() == TimebasedVer7 {
+		this.MostSigBits = (uint64(unixTimeMillis) & 0xFFFFFFFFFFFF) << 16 | this.MostSigBits & 0xFFFF
+		return
+	}
 	time100Nanos := (unixTimeMillis * one100NanosInMillis) + num100NanosSinceUUIDEpoch
 	this.SetTime100Nanos(time100Nanos)
 }
@@ -505,6 +528,9 @@ func (this*UUID) SetUnixTimeMillis(unixTimeMillis int64) {
  */
 
 func (this UUID) UnixTime100Nanos() int64 {
+	if this.Version() == TimebasedVer7 {
+		return this.UnixTimeMillis() * one100NanosInMillis
+	}
 	return this.Time100Nanos() - num100NanosSinceUUIDEpoch
 }
 
@@ -515,12 +541,18 @@ func (this UUID) UnixTime100Nanos() int64 {
  */
 
 func (this*UUID) SetUnixTime100Nanos(unixTime100Nanos int64) {
+	if this.Version() == TimebasedVer7 {
+		this.SetUnixTimeMillis(unixTime100Nanos / one100NanosInMillis)
+		return
+	}
 	this.SetTime100Nanos(unixTime100Nanos + num100NanosSinceUUIDEpoch)
 }
 
 
 /**
 	Gets Time from Time-based UUID
+
+    For version 7 it is the unix_ts_ms field with the millisecond precision
  */
 
 func (this UUID) Time() time.Time {
@@ -713,7 +745,9 @@ func ParseBytes(src []byte) (UUID, error) {
 			// xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
 		case 32:
 			var data [16]byte
-			hex.Decode(data[:], src)
+			if _, err := hex.Decode(data[:], src); err != nil {
+				return Empty, fmt.Errorf("invalid UUID format: %v", err)
+			}
 			var uuid UUID
 			err := uuid.UnmarshalBinary(data[:])
 			return uuid, err
@@ -755,8 +789,8 @@ func (this UUID) MarshalTextTo(dst []byte) error {
 		return ErrorWrongLen
 	}
 
-	data, err := this.MarshalBinary()
-	if err != nil {
+	var data [16]byte
+	if err := this.MarshalBinaryTo(data[:]); err != nil {
 		return err
 	}
 
@@ -846,6 +880,12 @@ func (v Version) String() string {
 		return "RandomlyGeneratedVer4"
 	case NamebasedVer5:
 		return "NamebasedVer5"
+	case ReorderedTimebasedVer6:
+		return "ReorderedTimebasedVer6"
+	case TimebasedVer7:
+		return "TimebasedVer7"
+	case CustomVer8:
+		return "CustomVer8"
 	}
 	return fmt.Sprintf("BadVersion%d", int(v))
 }
@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+/**
+	Lookup of standardized GUID spaces: Bluetooth SIG assigned numbers and EFI partition types
+
+	id := uuidcatalog.FromBluetooth16(0x180D)    // 0000180d-0000-1000-8000-00805f9b34fb
+	name, _ := uuidcatalog.BluetoothName(id)      // Heart Rate
+	name, _ = uuidcatalog.PartitionType(uuidcatalog.EFISystemPartition)
+	uuidcatalog.RegisterNames()                   // show catalog names in uuid.Inspect
+ */
+
+package uuidcatalog
+
+import (
+	"github.com/codeallergy/uuid"
+)
+
+/**
+	Bluetooth Base UUID 00000000-0000-1000-8000-00805F9B34FB, 16 and 32-bit UUIDs are offsets of its top 32 bits
+ */
+
+var BluetoothBase = uuid.UUID{MostSigBits: 0x0000000000001000, LeastSigBits: 0x800000805F9B34FB}
+
+/**
+	Names of common 16-bit GATT services and characteristics assigned by Bluetooth SIG
+ */
+
+var bluetoothNames = map[uint32]string{
+	0x1800: "Generic Access",
+	0x1801: "Generic Attribute",
+	0x180A: "Device Information",
+	0x180D: "Heart Rate",
+	0x180F: "Battery Service",
+	0x1810: "Blood Pressure",
+	0x1812: "Human Interface Device",
+	0x181A: "Environmental Sensing",
+	0x2A00: "Device Name",
+	0x2A19: "Battery Level",
+	0x2A29: "Manufacturer Name String",
+	0x2A37: "Heart Rate Measurement",
+}
+
+/**
+	Gets 128-bit UUID of the 16-bit Bluetooth UUID
+ */
+
+func FromBluetooth16(v uint16) uuid.UUID {
+	return FromBluetooth32(uint32(v))
+}
+
+/**
+	Gets 128-bit UUID of the 32-bit Bluetooth UUID
+ */
+
+func FromBluetooth32(v uint32) uuid.UUID {
+	id := BluetoothBase
+	id.MostSigBits |= uint64(v) << 32
+	return id
+}
+
+/**
+	Gets 32-bit Bluetooth UUID if the UUID is derived from the Bluetooth Base UUID
+ */
+
+func ToBluetooth32(id uuid.UUID) (uint32, bool) {
+	if id.MostSigBits&0xFFFFFFFF != BluetoothBase.MostSigBits || id.LeastSigBits != BluetoothBase.LeastSigBits {
+		return 0, false
+	}
+	return uint32(id.MostSigBits >> 32), true
+}
+
+/**
+	Gets 16-bit Bluetooth UUID if the UUID is derived from the Bluetooth Base UUID and fits 16 bits
+ */
+
+func ToBluetooth16(id uuid.UUID) (uint16, bool) {
+	v, ok := ToBluetooth32(id)
+	if !ok || v > 0xFFFF {
+		return 0, false
+	}
+	return uint16(v), true
+}
+
+/**
+	Gets name of the assigned Bluetooth service or characteristic
+ */
+
+func BluetoothName(id uuid.UUID) (string, bool) {
+	v, ok := ToBluetooth32(id)
+	if !ok {
+		return "", false
+	}
+	name, ok := bluetoothNames[v]
+	return name, ok
+}
@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuidcatalog_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/codeallergy/uuid/uuidcatalog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBluetooth(t *testing.T) {
+
+	id := uuidcatalog.FromBluetooth16(0x180D)
+	assert.Equal(t, "0000180d-0000-1000-8000-00805f9b34fb", id.String())
+
+	v16, ok := uuidcatalog.ToBluetooth16(id)
+	assert.True(t, ok)
+	assert.Equal(t, uint16(0x180D), v16)
+
+	name, ok := uuidcatalog.BluetoothName(id)
+	assert.True(t, ok)
+	assert.Equal(t, "Heart Rate", name)
+
+	id32 := uuidcatalog.FromBluetooth32(0x12345678)
+	assert.Equal(t, "12345678-0000-1000-8000-00805f9b34fb", id32.String())
+	v32, ok := uuidcatalog.ToBluetooth32(id32)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(0x12345678), v32)
+	_, ok = uuidcatalog.ToBluetooth16(id32)
+	assert.False(t, ok)
+	_, ok = uuidcatalog.BluetoothName(id32)
+	assert.False(t, ok)
+
+	_, ok = uuidcatalog.ToBluetooth32(uuid.NamespaceDNS)
+	assert.False(t, ok)
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuidcatalog
+
+import (
+	"github.com/codeallergy/uuid"
+)
+
+/**
+	GPT partition type GUIDs of the UEFI specification and common operating systems
+ */
+
+var (
+	EFIUnusedEntry     = uuid.Empty
+	EFISystemPartition = uuid.MustParse("c12a7328-f81f-11d2-ba4b-00a0c93ec93b")
+	BIOSBootPartition  = uuid.MustParse("21686148-6449-6e6f-744e-656564454649")
+	MicrosoftReserved  = uuid.MustParse("e3c9e316-0b5c-4db8-817d-f92df00215ae")
+	MicrosoftBasicData = uuid.MustParse("ebd0a0a2-b9e5-4433-87c0-68b6b72699c7")
+	WindowsRecovery    = uuid.MustParse("de94bba4-06d1-4d40-a16a-bfd50179d6ac")
+	LinuxFilesystem    = uuid.MustParse("0fc63daf-8483-4772-8e79-3d69d8477de4")
+	LinuxSwap          = uuid.MustParse("0657fd6d-a4ab-43c4-84e5-0933c84b4f4f")
+	LinuxLVM           = uuid.MustParse("e6d6d379-f507-44c2-a23c-238f2a3df928")
+	LinuxRAID          = uuid.MustParse("a19d880f-05fc-4d3b-a006-743f0f84911e")
+	LinuxHome          = uuid.MustParse("933ac7e1-2eb4-4f13-b844-0e14e2aef915")
+	LinuxRootX86_64    = uuid.MustParse("4f68bce3-e8cd-4db1-96e7-fbcaf984b709")
+	AppleHFSPlus       = uuid.MustParse("48465300-0000-11aa-aa11-00306543ecac")
+	AppleAPFS          = uuid.MustParse("7c3457ef-0000-11aa-aa11-00306543ecac")
+)
+
+/**
+	Vendor GUID of the UEFI global variables like BootOrder
+ */
+
+var EFIGlobalVariable = uuid.MustParse("8be4df61-93ca-11d2-aa0d-00e098032b8c")
+
+var partitionTypes = map[uuid.UUID]string{
+	EFIUnusedEntry:     "Unused entry",
+	EFISystemPartition: "EFI System Partition",
+	BIOSBootPartition:  "BIOS boot partition",
+	MicrosoftReserved:  "Microsoft Reserved Partition",
+	MicrosoftBasicData: "Microsoft Basic Data Partition",
+	WindowsRecovery:    "Windows Recovery Environment",
+	LinuxFilesystem:    "Linux filesystem data",
+	LinuxSwap:          "Linux swap",
+	LinuxLVM:           "Linux LVM",
+	LinuxRAID:          "Linux RAID",
+	LinuxHome:          "Linux /home",
+	LinuxRootX86_64:    "Linux root (x86-64)",
+	AppleHFSPlus:       "Apple HFS+",
+	AppleAPFS:          "Apple APFS",
+}
+
+/**
+	Gets name of the GPT partition type GUID
+ */
+
+func PartitionType(id uuid.UUID) (string, bool) {
+	name, ok := partitionTypes[id]
+	return name, ok
+}
+
+/**
+	Registers names of the catalog in the well-known names of the uuid package, so Inspect and the CLI show them
+
+    Bluetooth UUIDs are registered only for the named assigned numbers, the unused entry keeps its Nil name
+ */
+
+func RegisterNames() error {
+	for id, name := range partitionTypes {
+		if id == EFIUnusedEntry {
+			continue
+		}
+		if err := uuid.RegisterName(id, name); err != nil {
+			return err
+		}
+	}
+	if err := uuid.RegisterName(EFIGlobalVariable, "EFI Global Variable"); err != nil {
+		return err
+	}
+	for v, name := range bluetoothNames {
+		if err := uuid.RegisterName(FromBluetooth32(v), "Bluetooth "+name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
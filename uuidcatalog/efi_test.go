@@ -0,0 +1,34 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuidcatalog_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/codeallergy/uuid/uuidcatalog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartitionType(t *testing.T) {
+
+	name, ok := uuidcatalog.PartitionType(uuid.MustParse("C12A7328-F81F-11D2-BA4B-00A0C93EC93B"))
+	assert.True(t, ok)
+	assert.Equal(t, "EFI System Partition", name)
+
+	name, ok = uuidcatalog.PartitionType(uuidcatalog.LinuxFilesystem)
+	assert.True(t, ok)
+	assert.Equal(t, "Linux filesystem data", name)
+
+	_, ok = uuidcatalog.PartitionType(uuid.NamespaceDNS)
+	assert.False(t, ok)
+
+	assert.NoError(t, uuidcatalog.RegisterNames())
+	assert.NoError(t, uuidcatalog.RegisterNames())
+	assert.Equal(t, "EFI System Partition", uuidcatalog.EFISystemPartition.Inspect().Name)
+	assert.Equal(t, "Bluetooth Battery Level", uuidcatalog.FromBluetooth16(0x2A19).Inspect().Name)
+	assert.Equal(t, "Nil", uuid.Empty.Inspect().Name)
+}
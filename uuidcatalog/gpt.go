@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuidcatalog
+
+import (
+	"bytes"
+	"encoding/binary"
+	"unicode/utf16"
+
+	"github.com/codeallergy/uuid"
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+const (
+
+	/**
+		Size of the partition entry of the UEFI specification, the header may declare larger entries
+	 */
+
+	GPTEntrySize = 128
+
+	gptNameUnits = 36
+)
+
+var gptSignature = []byte("EFI PART")
+
+/**
+	Decodes GUID stored on disk with the first three fields in little-endian order
+ */
+
+func FromGPTBytes(raw []byte) (uuid.UUID, error) {
+	return uuid.Decode(raw, uuid.EncodingBinaryLE)
+}
+
+/**
+	Encodes GUID in to 16 bytes of the on-disk layout
+ */
+
+func ToGPTBytes(id uuid.UUID) []byte {
+	raw, _ := uuid.Encode(id, uuid.EncodingBinaryLE)
+	return raw
+}
+
+/**
+	GPT partition entry
+ */
+
+type GPTPartitionEntry struct {
+	TypeGUID   uuid.UUID
+	UniqueGUID uuid.UUID
+	FirstLBA   uint64
+	LastLBA    uint64
+	Attributes uint64
+
+	/**
+		Name of up to 36 UTF-16 code units
+	 */
+
+	Name string
+}
+
+/**
+	Returns true for the unused entry with the zero type GUID
+ */
+
+func (e GPTPartitionEntry) Unused() bool {
+	return e.TypeGUID == EFIUnusedEntry
+}
+
+/**
+	Decodes partition entry from the first 128 bytes
+ */
+
+func ParseGPTPartitionEntry(raw []byte) (e GPTPartitionEntry, err error) {
+
+	if len(raw) < GPTEntrySize {
+		return e, uuid.ErrorWrongLen
+	}
+
+	if e.TypeGUID, err = FromGPTBytes(raw[0:16]); err != nil {
+		return
+	}
+	if e.UniqueGUID, err = FromGPTBytes(raw[16:32]); err != nil {
+		return
+	}
+	e.FirstLBA = binary.LittleEndian.Uint64(raw[32:])
+	e.LastLBA = binary.LittleEndian.Uint64(raw[40:])
+	e.Attributes = binary.LittleEndian.Uint64(raw[48:])
+
+	units := make([]uint16, 0, gptNameUnits)
+	for i := 0; i < gptNameUnits; i++ {
+		u := binary.LittleEndian.Uint16(raw[56+2*i:])
+		if u == 0 {
+			break
+		}
+		units = append(units, u)
+	}
+	e.Name = string(utf16.Decode(units))
+	return e, nil
+}
+
+/**
+	Encodes partition entry in to 128 bytes, fails if the name exceeds 36 UTF-16 code units
+ */
+
+func (e GPTPartitionEntry) MarshalBinary() ([]byte, error) {
+
+	units := utf16.Encode([]rune(e.Name))
+	if len(units) > gptNameUnits {
+		return nil, errors.Errorf("partition name %q exceeds %d UTF-16 code units", e.Name, gptNameUnits)
+	}
+
+	raw := make([]byte, GPTEntrySize)
+	copy(raw[0:], ToGPTBytes(e.TypeGUID))
+	copy(raw[16:], ToGPTBytes(e.UniqueGUID))
+	binary.LittleEndian.PutUint64(raw[32:], e.FirstLBA)
+	binary.LittleEndian.PutUint64(raw[40:], e.LastLBA)
+	binary.LittleEndian.PutUint64(raw[48:], e.Attributes)
+	for i, u := range units {
+		binary.LittleEndian.PutUint16(raw[56+2*i:], u)
+	}
+	return raw, nil
+}
+
+/**
+	Gets disk GUID from the GPT header, usually LBA 1
+ */
+
+func GPTDiskGUID(header []byte) (uuid.UUID, error) {
+	if len(header) < 92 {
+		return uuid.Empty, uuid.ErrorWrongLen
+	}
+	if !bytes.Equal(header[:8], gptSignature) {
+		return uuid.Empty, errors.New("missing GPT header signature")
+	}
+	return FromGPTBytes(header[56:72])
+}
@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuidcatalog_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/codeallergy/uuid/uuidcatalog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGPT(t *testing.T) {
+
+	// EFI System Partition type as written by fdisk
+	raw, _ := hex.DecodeString("28732ac11ff8d211ba4b00a0c93ec93b")
+	id, err := uuidcatalog.FromGPTBytes(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, uuidcatalog.EFISystemPartition, id)
+	assert.Equal(t, raw, uuidcatalog.ToGPTBytes(id))
+
+	entry := uuidcatalog.GPTPartitionEntry{
+		TypeGUID:   uuidcatalog.EFISystemPartition,
+		UniqueGUID: uuid.MustParse("534b44a1-9bf1-3d20-b71e-cc4eb77c572f"),
+		FirstLBA:   2048,
+		LastLBA:    1050623,
+		Attributes: 1,
+		Name:       "EFI system partition",
+	}
+	data, err := entry.MarshalBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, uuidcatalog.GPTEntrySize, len(data))
+	assert.Equal(t, raw, data[:16])
+
+	parsed, err := uuidcatalog.ParseGPTPartitionEntry(data)
+	assert.NoError(t, err)
+	assert.Equal(t, entry, parsed)
+	assert.False(t, parsed.Unused())
+
+	unused, err := uuidcatalog.ParseGPTPartitionEntry(make([]byte, 128))
+	assert.NoError(t, err)
+	assert.True(t, unused.Unused())
+
+	_, err = uuidcatalog.ParseGPTPartitionEntry(data[:127])
+	assert.Error(t, err)
+	entry.Name = strings.Repeat("x", 37)
+	_, err = entry.MarshalBinary()
+	assert.Error(t, err)
+
+	header := make([]byte, 92)
+	copy(header, "EFI PART")
+	copy(header[56:], uuidcatalog.ToGPTBytes(entry.UniqueGUID))
+	disk, err := uuidcatalog.GPTDiskGUID(header)
+	assert.NoError(t, err)
+	assert.Equal(t, entry.UniqueGUID, disk)
+
+	header[0] = 'X'
+	_, err = uuidcatalog.GPTDiskGUID(header)
+	assert.Error(t, err)
+}
@@ -0,0 +1,12 @@
+module github.com/codeallergy/uuid/uuidgopter
+
+go 1.18
+
+replace github.com/codeallergy/uuid => ../
+
+require (
+	github.com/codeallergy/uuid v0.0.0-00010101000000-000000000000
+	github.com/leanovate/gopter v0.2.11
+)
+
+require github.com/pkg/errors v0.9.1 // indirect
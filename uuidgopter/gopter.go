@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+/**
+	Generators of UUIDs for github.com/leanovate/gopter property-based tests
+
+	properties.Property("round trip", prop.ForAll(func(id uuid.UUID) bool {
+		...
+	}, uuidgopter.UUID()))
+
+    Separate module, so the uuid module stays free of the gopter dependency.
+ */
+
+package uuidgopter
+
+import (
+	"github.com/codeallergy/uuid"
+	"github.com/codeallergy/uuid/uuidtest"
+	"github.com/leanovate/gopter"
+)
+
+/**
+	Generates valid IETF variant UUIDs of all versions
+ */
+
+func UUID() gopter.Gen {
+	return func(p *gopter.GenParameters) *gopter.GenResult {
+		return gopter.NewGenResult(uuidtest.Arbitrary(p.Rng), gopter.NoShrinker)
+	}
+}
+
+/**
+	Generates valid IETF variant UUIDs of the version
+ */
+
+func UUIDVersion(version uuid.Version) gopter.Gen {
+	return func(p *gopter.GenParameters) *gopter.GenResult {
+		return gopter.NewGenResult(uuidtest.ArbitraryVersion(p.Rng, version), gopter.NoShrinker)
+	}
+}
+
+/**
+	Generates any 128-bit values, including non-IETF variants and unknown versions
+ */
+
+func Bits() gopter.Gen {
+	return func(p *gopter.GenParameters) *gopter.GenResult {
+		return gopter.NewGenResult(uuidtest.ArbitraryBits(p.Rng), gopter.NoShrinker)
+	}
+}
+
+/**
+	Generates strings close to the canonical form that must be rejected by uuid.Parse, see uuidtest.NearValidString
+ */
+
+func NearValidString() gopter.Gen {
+	return func(p *gopter.GenParameters) *gopter.GenResult {
+		return gopter.NewGenResult(uuidtest.NearValidString(p.Rng), gopter.NoShrinker)
+	}
+}
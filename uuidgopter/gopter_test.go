@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuidgopter_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/codeallergy/uuid/uuidgopter"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestGenerators(t *testing.T) {
+
+	properties := gopter.NewProperties(nil)
+
+	properties.Property("round trip", prop.ForAll(func(id uuid.UUID) bool {
+		parsed, err := uuid.Parse(id.String())
+		return err == nil && parsed == id && id.Variant() == uuid.IETF
+	}, uuidgopter.UUID()))
+
+	properties.Property("version", prop.ForAll(func(id uuid.UUID) bool {
+		return id.Version() == uuid.TimebasedVer7
+	}, uuidgopter.UUIDVersion(uuid.TimebasedVer7)))
+
+	properties.Property("bits", prop.ForAll(func(id uuid.UUID) bool {
+		return uuid.ComparePostgres(id, id) == 0
+	}, uuidgopter.Bits()))
+
+	properties.Property("near valid", prop.ForAll(func(s string) bool {
+		_, err := uuid.Parse(s)
+		return err != nil
+	}, uuidgopter.NearValidString()))
+
+	properties.TestingRun(t)
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+/**
+	HTTP helpers for UUID request identifiers
+ */
+
+package uuidhttp
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/codeallergy/uuid"
+)
+
+/**
+	Default header carrying the request ID
+ */
+
+const RequestIDHeader = "X-Request-ID"
+
+/**
+	Reads request ID from X-Request-ID header, generates v7 UUID when it is missing or invalid,
+    stores it in the request context and echoes it in the response header
+
+    If the ID can not be generated, e.g. the process is frozen by uuid.Freeze or the uuid_frozen build tag,
+    the request is passed on without the ID, FromContext reports false and the response has no header.
+ */
+
+func Middleware(next http.Handler) http.Handler {
+	return MiddlewareWith(nil, RequestIDHeader)(next)
+}
+
+/**
+	Creates request ID middleware with the custom generator and header
+
+    Uses v7 UUIDs if generator is nil and X-Request-ID if header is empty,
+    generator errors pass the request on without the ID
+ */
+
+func MiddlewareWith(gen uuid.Generator, header string) func(http.Handler) http.Handler {
+
+	if header == "" {
+		header = RequestIDHeader
+	}
+
+	next := uuid.NewV7
+	if gen != nil {
+		next = gen.Next
+	}
+
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+			id, err := uuid.Parse(r.Header.Get(header))
+			if err != nil {
+				id, err = next()
+				if err != nil {
+					// the request ID is diagnostics only, serve the request without it
+					h.ServeHTTP(w, r)
+					return
+				}
+			}
+
+			w.Header().Set(header, id.String())
+			h.ServeHTTP(w, r.WithContext(NewContext(r.Context(), id)))
+		})
+	}
+}
+
+/**
+	Stores request ID in the context, same as uuid.NewContext
+ */
+
+func NewContext(ctx context.Context, id uuid.UUID) context.Context {
+	return uuid.NewContext(ctx, id)
+}
+
+/**
+	Gets request ID stored by the middleware, same as uuid.FromContext
+ */
+
+func FromContext(ctx context.Context) (uuid.UUID, bool) {
+	return uuid.FromContext(ctx)
+}
+
+/**
+	Gets request ID stored by the middleware or Empty
+ */
+
+func FromRequest(r *http.Request) uuid.UUID {
+	id, _ := FromContext(r.Context())
+	return id
+}
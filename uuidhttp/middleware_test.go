@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuidhttp_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/codeallergy/uuid/uuidhttp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiddleware(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	var seen uuid.UUID
+	handler := uuidhttp.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var ok bool
+		seen, ok = uuidhttp.FromContext(r.Context())
+		assert.True(t, ok)
+	}))
+
+	// generates v7 when missing
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+	assert.Equal(t, uuid.TimebasedVer7, seen.Version())
+	assert.Equal(t, seen.String(), rec.Header().Get(uuidhttp.RequestIDHeader))
+
+	// keeps valid incoming ID in canonical form
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set(uuidhttp.RequestIDHeader, "534B44A1-9BF1-3D20-B71E-CC4EB77C572F")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, "534b44a1-9bf1-3d20-b71e-cc4eb77c572f", seen.String())
+	assert.Equal(t, "534b44a1-9bf1-3d20-b71e-cc4eb77c572f", rec.Header().Get(uuidhttp.RequestIDHeader))
+
+	// replaces invalid incoming ID
+	req = httptest.NewRequest("GET", "/", nil)
+	req.Header.Set(uuidhttp.RequestIDHeader, "<script>")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, uuid.TimebasedVer7, seen.Version())
+
+	_, ok := uuidhttp.FromContext(req.Context())
+	assert.False(t, ok)
+	assert.Equal(t, uuid.Empty, uuidhttp.FromRequest(req))
+
+}
+
+func TestMiddlewareGeneratorError(t *testing.T) {
+
+	failing := uuid.GeneratorFunc(func() (uuid.UUID, error) {
+		return uuid.Empty, errors.New("entropy source is down")
+	})
+
+	served, ok := false, false
+	handler := uuidhttp.MiddlewareWith(failing, "")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served = true
+		_, ok = uuidhttp.FromContext(r.Context())
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+	assert.True(t, served)
+	assert.False(t, ok)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get(uuidhttp.RequestIDHeader))
+
+	// valid incoming ID does not need the generator
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set(uuidhttp.RequestIDHeader, "534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.True(t, ok)
+	assert.Equal(t, "534b44a1-9bf1-3d20-b71e-cc4eb77c572f", rec.Header().Get(uuidhttp.RequestIDHeader))
+
+}
+
+func TestSharedContext(t *testing.T) {
+
+	handler := uuidhttp.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, ok := uuid.FromContext(r.Context())
+		assert.True(t, ok)
+		assert.Equal(t, "534b44a1-9bf1-3d20-b71e-cc4eb77c572f", id.String())
+	}))
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set(uuidhttp.RequestIDHeader, "534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+}
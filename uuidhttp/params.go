@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuidhttp
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/codeallergy/uuid"
+)
+
+/**
+	Route context with named path parameters
+
+    Satisfied by echo.Context and *gin.Context
+ */
+
+type ParamContext interface {
+	Param(name string) string
+}
+
+/**
+	Function reading named path parameter from the request
+
+    Satisfied by chi.URLParam and mux-style helpers
+ */
+
+type ParamFunc func(r *http.Request, name string) string
+
+/**
+	Error returned for missing or malformed UUID path parameters, Name is empty for Param fields
+
+    Always maps to 400 Bad Request
+ */
+
+type ParamError struct {
+	Name  string
+	Value string
+	Err   error
+}
+
+func (e *ParamError) Error() string {
+	switch {
+	case e.Name == "" && e.Value == "":
+		return "missing UUID"
+	case e.Name == "":
+		return fmt.Sprintf("invalid UUID: %q", e.Value)
+	case e.Value == "":
+		return fmt.Sprintf("missing UUID path parameter %q", e.Name)
+	default:
+		return fmt.Sprintf("invalid UUID path parameter %q: %q", e.Name, e.Value)
+	}
+}
+
+func (e *ParamError) Unwrap() error {
+	return e.Err
+}
+
+/**
+	Gets HTTP status code for the error, always 400
+ */
+
+func (e *ParamError) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+/**
+	Parses UUID from the named path parameter of echo or gin context
+ */
+
+func BindParam(c ParamContext, name string) (uuid.UUID, error) {
+	return parseParam(name, c.Param(name))
+}
+
+/**
+	Parses UUID from the named path parameter, e.g. BindRequestParam(r, "id", chi.URLParam)
+ */
+
+func BindRequestParam(r *http.Request, name string, param ParamFunc) (uuid.UUID, error) {
+	return parseParam(name, param(r, name))
+}
+
+/**
+	Parses UUID from the named query parameter
+ */
+
+func BindQuery(r *http.Request, name string) (uuid.UUID, error) {
+	return parseParam(name, r.URL.Query().Get(name))
+}
+
+/**
+	Writes ParamError as plain text 400 response, other errors as 500
+ */
+
+func WriteError(w http.ResponseWriter, err error) {
+	if pe, ok := err.(*ParamError); ok {
+		http.Error(w, pe.Error(), pe.StatusCode())
+		return
+	}
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
+
+func parseParam(name, value string) (uuid.UUID, error) {
+	if value == "" {
+		return uuid.Empty, &ParamError{Name: name}
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Empty, &ParamError{Name: name, Value: value, Err: err}
+	}
+	return id, nil
+}
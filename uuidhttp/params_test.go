@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuidhttp_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/codeallergy/uuid/uuidhttp"
+	"github.com/stretchr/testify/assert"
+)
+
+type routeContext map[string]string
+
+func (c routeContext) Param(name string) string {
+	return c[name]
+}
+
+func TestBindParam(t *testing.T) {
+
+	id, err := uuidhttp.BindParam(routeContext{"id": "534b44a1-9bf1-3d20-b71e-cc4eb77c572f"}, "id")
+	assert.NoError(t, err)
+	assert.Equal(t, "534b44a1-9bf1-3d20-b71e-cc4eb77c572f", id.String())
+
+	_, err = uuidhttp.BindParam(routeContext{}, "id")
+	assert.EqualError(t, err, `missing UUID path parameter "id"`)
+
+	_, err = uuidhttp.BindParam(routeContext{"id": "42"}, "id")
+	assert.EqualError(t, err, `invalid UUID path parameter "id": "42"`)
+
+	pe, ok := err.(*uuidhttp.ParamError)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusBadRequest, pe.StatusCode())
+	assert.Error(t, pe.Unwrap())
+
+	rec := httptest.NewRecorder()
+	uuidhttp.WriteError(rec, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	req := httptest.NewRequest("GET", "/orders?id=534b44a1-9bf1-3d20-b71e-cc4eb77c572f", nil)
+	id, err = uuidhttp.BindQuery(req, "id")
+	assert.NoError(t, err)
+	assert.Equal(t, "534b44a1-9bf1-3d20-b71e-cc4eb77c572f", id.String())
+
+	urlParam := func(r *http.Request, name string) string { return "534b44a1-9bf1-3d20-b71e-cc4eb77c572f" }
+	id, err = uuidhttp.BindRequestParam(req, "id", urlParam)
+	assert.NoError(t, err)
+	assert.Equal(t, "534b44a1-9bf1-3d20-b71e-cc4eb77c572f", id.String())
+
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuidhttp
+
+import (
+	"reflect"
+
+	"github.com/codeallergy/uuid"
+)
+
+/**
+	UUID field of request structs bound by echo, gin or form binders through encoding.TextUnmarshaler
+
+    Malformed values fail the binding with *ParamError, so the binder error maps to 400 Bad Request.
+    Nil UUID is accepted by the binding and rejected by Validate.
+ */
+
+type Param uuid.UUID
+
+/**
+	Parses UUID in any format accepted by uuid.Parse
+
+    UnmarshalText implements the encoding.TextUnmarshaler interface.
+ */
+
+func (this *Param) UnmarshalText(text []byte) error {
+	id, err := parseParam("", string(text))
+	if err != nil {
+		return err
+	}
+	*this = Param(id)
+	return nil
+}
+
+/**
+	Writes canonical form
+
+    MarshalText implements the encoding.TextMarshaler interface.
+ */
+
+func (this Param) MarshalText() ([]byte, error) {
+	return []byte(uuid.UUID(this).String()), nil
+}
+
+/**
+	Gets the bound UUID
+ */
+
+func (this Param) UUID() uuid.UUID {
+	return uuid.UUID(this)
+}
+
+/**
+	Rejects Nil UUID with *ParamError, hook of validators calling Validate() error on the fields
+ */
+
+func (this Param) Validate() error {
+	if uuid.UUID(this).Equal(uuid.Empty) {
+		return &ParamError{}
+	}
+	return nil
+}
+
+/**
+	Custom type function of struct-tag validators, e.g. go-playground validator.RegisterCustomTypeFunc
+
+    Gets canonical string of Param and uuid.UUID fields and empty string for Nil UUID,
+    so tags like validate:"required" or validate:"uuid4" apply to them, nil for other types.
+ */
+
+func ValidatorValue(field reflect.Value) interface{} {
+	var id uuid.UUID
+	switch v := field.Interface().(type) {
+	case Param:
+		id = uuid.UUID(v)
+	case uuid.UUID:
+		id = v
+	default:
+		return nil
+	}
+	if id.Equal(uuid.Empty) {
+		return ""
+	}
+	return id.String()
+}
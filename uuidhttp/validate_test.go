@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuidhttp_test
+
+import (
+	"encoding"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/codeallergy/uuid/uuidhttp"
+	"github.com/stretchr/testify/assert"
+)
+
+type orderRequest struct {
+	ID uuidhttp.Param `json:"id"`
+}
+
+func TestParam(t *testing.T) {
+
+	var _ encoding.TextUnmarshaler = (*uuidhttp.Param)(nil)
+
+	var req orderRequest
+	assert.NoError(t, json.Unmarshal([]byte(`{"id":"534B44A1-9BF1-3D20-B71E-CC4EB77C572F"}`), &req))
+	assert.Equal(t, "534b44a1-9bf1-3d20-b71e-cc4eb77c572f", req.ID.UUID().String())
+	assert.NoError(t, req.ID.Validate())
+
+	data, err := json.Marshal(req)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id":"534b44a1-9bf1-3d20-b71e-cc4eb77c572f"}`, string(data))
+
+	var p uuidhttp.Param
+	err = p.UnmarshalText([]byte("42"))
+	assert.EqualError(t, err, `invalid UUID: "42"`)
+	pe, ok := err.(*uuidhttp.ParamError)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusBadRequest, pe.StatusCode())
+
+	assert.NoError(t, p.UnmarshalText([]byte("00000000-0000-0000-0000-000000000000")))
+	assert.EqualError(t, p.Validate(), "missing UUID")
+
+	assert.Equal(t, "534b44a1-9bf1-3d20-b71e-cc4eb77c572f", uuidhttp.ValidatorValue(reflect.ValueOf(req.ID)))
+	assert.Equal(t, "534b44a1-9bf1-3d20-b71e-cc4eb77c572f", uuidhttp.ValidatorValue(reflect.ValueOf(req.ID.UUID())))
+	assert.Equal(t, "", uuidhttp.ValidatorValue(reflect.ValueOf(uuid.Empty)))
+	assert.Nil(t, uuidhttp.ValidatorValue(reflect.ValueOf(42)))
+}
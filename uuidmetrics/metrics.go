@@ -0,0 +1,176 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+/**
+	Metrics of UUID generation exported via expvar and Prometheus text format
+
+	m := uuidmetrics.New()
+	m.Install()
+	m.Publish("uuid")
+	http.Handle("/metrics/uuid", m)
+
+    Registries of github.com/prometheus/client_golang use uuidprom.NewCollector(m) from the separate uuidprom module.
+ */
+
+package uuidmetrics
+
+import (
+	"expvar"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/codeallergy/uuid"
+)
+
+const numVersions = int(uuid.CustomVer8) + 1
+
+/**
+	Counters of generator events, safe for concurrent use
+ */
+
+type Metrics struct {
+	generated        [numVersions]uint64
+	clockRegressions uint64
+	counterOverflows uint64
+	entropyErrors    uint64
+
+	/**
+		Gauge of the last clock regression in nanoseconds
+	 */
+
+	lastRegression int64
+}
+
+/**
+	Point-in-time values of the metrics
+ */
+
+type Snapshot struct {
+	Generated                map[string]uint64 `json:"generated"`
+	ClockRegressions         uint64            `json:"clockRegressions"`
+	CounterOverflows         uint64            `json:"counterOverflows"`
+	EntropyErrors            uint64            `json:"entropyErrors"`
+	LastClockRegressionNanos int64             `json:"lastClockRegressionNanos"`
+}
+
+/**
+	Creates empty metrics
+ */
+
+func New() *Metrics {
+	return &Metrics{}
+}
+
+/**
+	Gets hooks updating the metrics
+ */
+
+func (m *Metrics) Hooks() uuid.Hooks {
+	return uuid.Hooks{
+		OnGenerate: func(id uuid.UUID) {
+			if v := int(id.Version()); v < numVersions {
+				atomic.AddUint64(&m.generated[v], 1)
+			}
+		},
+		OnClockRegression: func(version uuid.Version, backwards time.Duration) {
+			atomic.AddUint64(&m.clockRegressions, 1)
+			atomic.StoreInt64(&m.lastRegression, int64(backwards))
+		},
+		OnCounterOverflow: func(version uuid.Version) {
+			atomic.AddUint64(&m.counterOverflows, 1)
+		},
+		OnEntropyError: func(err error) {
+			atomic.AddUint64(&m.entropyErrors, 1)
+		},
+	}
+}
+
+/**
+	Installs the hooks process-wide, returns previously installed hooks
+
+    The installed OnMint is kept, so installing metrics does not switch off the process-wide audit.
+ */
+
+func (m *Metrics) Install() uuid.Hooks {
+	h := m.Hooks()
+	h.OnMint = uuid.GetHooks().OnMint
+	return uuid.SetHooks(h)
+}
+
+/**
+	Gets current values
+ */
+
+func (m *Metrics) Snapshot() Snapshot {
+	s := Snapshot{
+		Generated:                make(map[string]uint64),
+		ClockRegressions:         atomic.LoadUint64(&m.clockRegressions),
+		CounterOverflows:         atomic.LoadUint64(&m.counterOverflows),
+		EntropyErrors:            atomic.LoadUint64(&m.entropyErrors),
+		LastClockRegressionNanos: atomic.LoadInt64(&m.lastRegression),
+	}
+	for v := 1; v < numVersions; v++ {
+		if n := atomic.LoadUint64(&m.generated[v]); n > 0 {
+			s.Generated[fmt.Sprintf("v%d", v)] = n
+		}
+	}
+	return s
+}
+
+/**
+	Publishes snapshot as expvar variable with the name, panics if the name is already registered
+ */
+
+func (m *Metrics) Publish(name string) {
+	expvar.Publish(name, expvar.Func(func() interface{} {
+		return m.Snapshot()
+	}))
+}
+
+/**
+	Writes metrics in Prometheus text exposition format 0.0.4
+ */
+
+func (m *Metrics) WritePrometheus(w io.Writer) error {
+
+	s := m.Snapshot()
+
+	fmt.Fprintln(w, "# HELP uuid_generated_total Number of generated UUIDs by version.")
+	fmt.Fprintln(w, "# TYPE uuid_generated_total counter")
+	for v := 1; v < numVersions; v++ {
+		fmt.Fprintf(w, "uuid_generated_total{version=\"%d\"} %d\n", v, atomic.LoadUint64(&m.generated[v]))
+	}
+
+	fmt.Fprintln(w, "# HELP uuid_clock_regressions_total Number of times the wall clock moved backwards.")
+	fmt.Fprintln(w, "# TYPE uuid_clock_regressions_total counter")
+	fmt.Fprintf(w, "uuid_clock_regressions_total %d\n", s.ClockRegressions)
+
+	fmt.Fprintln(w, "# HELP uuid_last_clock_regression_seconds Size of the last backward clock step.")
+	fmt.Fprintln(w, "# TYPE uuid_last_clock_regression_seconds gauge")
+	fmt.Fprintf(w, "uuid_last_clock_regression_seconds %g\n", time.Duration(s.LastClockRegressionNanos).Seconds())
+
+	fmt.Fprintln(w, "# HELP uuid_counter_overflows_total Number of counter overflows within one clock tick.")
+	fmt.Fprintln(w, "# TYPE uuid_counter_overflows_total counter")
+	fmt.Fprintf(w, "uuid_counter_overflows_total %d\n", s.CounterOverflows)
+
+	fmt.Fprintln(w, "# HELP uuid_entropy_errors_total Number of failed reads of random bits.")
+	fmt.Fprintln(w, "# TYPE uuid_entropy_errors_total counter")
+	_, err := fmt.Fprintf(w, "uuid_entropy_errors_total %d\n", s.EntropyErrors)
+	return err
+}
+
+/**
+	Serves metrics in Prometheus text format, compatible with promhttp scrapers
+
+    ServeHTTP implements the http.Handler interface.
+ */
+
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	m.WritePrometheus(w)
+}
@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuidmetrics_test
+
+import (
+	"expvar"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/codeallergy/uuid/uuidmetrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetrics(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	m := uuidmetrics.New()
+	prev := m.Install()
+	defer uuid.SetHooks(prev)
+
+	for i := 0; i < 3; i++ {
+		_, err := uuid.NewV7()
+		assert.NoError(t, err)
+	}
+	_, err := uuid.NewRandomGenerator().Next()
+	assert.NoError(t, err)
+
+	gen, err := uuid.NewTimeGenerator(uuid.TimebasedVer1)
+	assert.NoError(t, err)
+	now := time.Now()
+	gen.SetClock(func() time.Time { return now })
+	gen.Next()
+	now = now.Add(-time.Millisecond)
+	gen.Next()
+
+	s := m.Snapshot()
+	assert.Equal(t, uint64(3), s.Generated["v7"])
+	assert.Equal(t, uint64(1), s.Generated["v4"])
+	assert.Equal(t, uint64(2), s.Generated["v1"])
+	assert.Equal(t, uint64(1), s.ClockRegressions)
+	assert.Equal(t, int64(time.Millisecond), s.LastClockRegressionNanos)
+
+	m.Publish("uuid_test")
+	assert.Contains(t, expvar.Get("uuid_test").String(), `"v7":3`)
+
+	rec := httptest.NewRecorder()
+	m.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body := rec.Body.String()
+	assert.True(t, strings.HasPrefix(rec.Header().Get("Content-Type"), "text/plain; version=0.0.4"))
+	assert.Contains(t, body, `uuid_generated_total{version="7"} 3`)
+	assert.Contains(t, body, "uuid_clock_regressions_total 1\n")
+	assert.Contains(t, body, "uuid_last_clock_regression_seconds 0.001\n")
+
+}
+
+func TestInstallKeepsOnMint(t *testing.T) {
+
+	minted := 0
+	prev := uuid.SetHooks(uuid.Hooks{OnMint: func(id uuid.UUID) error {
+		minted++
+		return nil
+	}})
+	defer uuid.SetHooks(prev)
+
+	uuidmetrics.New().Install()
+	if assert.NotNil(t, uuid.GetHooks().OnMint) {
+		assert.NoError(t, uuid.GetHooks().OnMint(uuid.Empty))
+	}
+	assert.Equal(t, 1, minted)
+}
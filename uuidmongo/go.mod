@@ -0,0 +1,12 @@
+module github.com/codeallergy/uuid/uuidmongo
+
+go 1.18
+
+replace github.com/codeallergy/uuid => ../
+
+require (
+	github.com/codeallergy/uuid v0.0.0-00010101000000-000000000000
+	go.mongodb.org/mongo-driver v1.17.6
+)
+
+require github.com/pkg/errors v0.9.1 // indirect
@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+/**
+	BSON codec of uuid.UUID for go.mongodb.org/mongo-driver registries
+
+	reg := bson.NewRegistry()
+	uuidmongo.Register(reg, uuid.MongoJavaLegacy)
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri).SetRegistry(reg))
+
+    Separate module, so the uuid module stays free of the MongoDB driver dependency.
+ */
+
+package uuidmongo
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/codeallergy/uuid"
+	"go.mongodb.org/mongo-driver/bson/bsoncodec"
+	"go.mongodb.org/mongo-driver/bson/bsonrw"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+const (
+	subtypeLegacy   = byte(0x03)
+	subtypeStandard = byte(0x04)
+)
+
+var typeUUID = reflect.TypeOf(uuid.UUID{})
+
+/**
+	Encodes UUID as BSON binary subtype 3 in the byte order of the legacy driver
+
+    Decodes subtype 3 in the same byte order and subtype 4 in the standard big-endian order,
+    so collections keep reading while being migrated to subtype 4. BSON null decodes to uuid.Empty.
+ */
+
+type Codec struct {
+	flavor uuid.MongoFlavor
+}
+
+/**
+	Creates codec for the byte order of the legacy driver
+ */
+
+func NewCodec(flavor uuid.MongoFlavor) *Codec {
+	return &Codec{flavor: flavor}
+}
+
+/**
+	Registers codec of uuid.UUID for the flavor in the registry
+ */
+
+func Register(reg *bsoncodec.Registry, flavor uuid.MongoFlavor) {
+	codec := NewCodec(flavor)
+	reg.RegisterTypeEncoder(typeUUID, codec)
+	reg.RegisterTypeDecoder(typeUUID, codec)
+}
+
+/**
+	Writes UUID as the legacy subtype 3 binary
+
+    EncodeValue implements the bsoncodec.ValueEncoder interface.
+ */
+
+func (this *Codec) EncodeValue(ec bsoncodec.EncodeContext, vw bsonrw.ValueWriter, val reflect.Value) error {
+	if !val.IsValid() || val.Type() != typeUUID {
+		return bsoncodec.ValueEncoderError{Name: "uuidmongo.Codec", Types: []reflect.Type{typeUUID}, Received: val}
+	}
+	data, err := uuid.ToLegacyMongo(val.Interface().(uuid.UUID), this.flavor)
+	if err != nil {
+		return err
+	}
+	return vw.WriteBinaryWithSubtype(data, subtypeLegacy)
+}
+
+/**
+	Reads UUID from the legacy subtype 3 or the standard subtype 4 binary
+
+    DecodeValue implements the bsoncodec.ValueDecoder interface.
+ */
+
+func (this *Codec) DecodeValue(dc bsoncodec.DecodeContext, vr bsonrw.ValueReader, val reflect.Value) error {
+	if !val.CanSet() || val.Type() != typeUUID {
+		return bsoncodec.ValueDecoderError{Name: "uuidmongo.Codec", Types: []reflect.Type{typeUUID}, Received: val}
+	}
+
+	var id uuid.UUID
+
+	switch vr.Type() {
+	case bsontype.Null:
+		if err := vr.ReadNull(); err != nil {
+			return err
+		}
+	case bsontype.Binary:
+		data, subtype, err := vr.ReadBinary()
+		if err != nil {
+			return err
+		}
+		switch subtype {
+		case subtypeLegacy:
+			id, err = uuid.FromLegacyMongo(data, this.flavor)
+		case subtypeStandard:
+			err = id.UnmarshalBinary(data)
+		default:
+			return fmt.Errorf("cannot decode binary subtype %#x into UUID", subtype)
+		}
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("cannot decode %v into UUID", vr.Type())
+	}
+
+	val.Set(reflect.ValueOf(id))
+	return nil
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuidmongo_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/codeallergy/uuid/uuidmongo"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type document struct {
+	ID     uuid.UUID  `bson:"_id"`
+	Parent *uuid.UUID `bson:"parent"`
+}
+
+func TestCodec(t *testing.T) {
+
+	id := uuid.MustParse("00112233-4455-6677-8899-aabbccddeeff")
+
+	for _, flavor := range []uuid.MongoFlavor{uuid.MongoPythonLegacy, uuid.MongoJavaLegacy, uuid.MongoCSharpLegacy} {
+		reg := bson.NewRegistry()
+		uuidmongo.Register(reg, flavor)
+
+		data, err := bson.MarshalWithRegistry(reg, document{ID: id, Parent: &id})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		raw := bson.Raw(data).Lookup("_id")
+		subtype, stored := raw.Binary()
+		legacy, _ := uuid.ToLegacyMongo(id, flavor)
+		if subtype != 0x03 || !bytes.Equal(stored, legacy) {
+			t.Errorf("%v: stored subtype %#x %x, want subtype 3 %x", flavor, subtype, stored, legacy)
+		}
+
+		var doc document
+		if err := bson.UnmarshalWithRegistry(reg, data, &doc); err != nil {
+			t.Fatal(err)
+		}
+		if doc.ID != id || doc.Parent == nil || *doc.Parent != id {
+			t.Errorf("%v: decoded %v %v, want %v", flavor, doc.ID, doc.Parent, id)
+		}
+
+		// standard subtype 4 is decoded in big-endian order whatever the flavor
+		standard, _ := id.MarshalBinary()
+		data, err = bson.Marshal(bson.M{"_id": primitive.Binary{Subtype: 0x04, Data: standard}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		doc = document{}
+		if err := bson.UnmarshalWithRegistry(reg, data, &doc); err != nil {
+			t.Fatal(err)
+		}
+		if doc.ID != id || doc.Parent != nil {
+			t.Errorf("%v: decoded subtype 4 as %v", flavor, doc.ID)
+		}
+	}
+
+	reg := bson.NewRegistry()
+	uuidmongo.Register(reg, uuid.MongoJavaLegacy)
+	data, _ := bson.Marshal(bson.M{"_id": "not a uuid"})
+	var doc document
+	if err := bson.UnmarshalWithRegistry(reg, data, &doc); err == nil {
+		t.Error("decoded string into UUID")
+	}
+}
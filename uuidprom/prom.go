@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+/**
+	Prometheus collector of uuidmetrics for github.com/prometheus/client_golang registries
+
+	m := uuidmetrics.New()
+	m.Install()
+	prometheus.MustRegister(uuidprom.NewCollector(m))
+
+    Separate module, so the uuid module stays free of the Prometheus client dependency.
+ */
+
+package uuidprom
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/codeallergy/uuid/uuidmetrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+/**
+	Collector exposing the same metrics as uuidmetrics.Metrics.WritePrometheus
+ */
+
+type Collector struct {
+	metrics *uuidmetrics.Metrics
+
+	generated        *prometheus.Desc
+	clockRegressions *prometheus.Desc
+	lastRegression   *prometheus.Desc
+	counterOverflows *prometheus.Desc
+	entropyErrors    *prometheus.Desc
+}
+
+/**
+	Creates collector reading snapshots of the metrics on every scrape
+ */
+
+func NewCollector(m *uuidmetrics.Metrics) *Collector {
+	return &Collector{
+		metrics:          m,
+		generated:        prometheus.NewDesc("uuid_generated_total", "Number of generated UUIDs by version.", []string{"version"}, nil),
+		clockRegressions: prometheus.NewDesc("uuid_clock_regressions_total", "Number of times the wall clock moved backwards.", nil, nil),
+		lastRegression:   prometheus.NewDesc("uuid_last_clock_regression_seconds", "Size of the last backward clock step.", nil, nil),
+		counterOverflows: prometheus.NewDesc("uuid_counter_overflows_total", "Number of counter overflows within one clock tick.", nil, nil),
+		entropyErrors:    prometheus.NewDesc("uuid_entropy_errors_total", "Number of failed reads of random bits.", nil, nil),
+	}
+}
+
+/**
+	Describe implements the prometheus.Collector interface.
+ */
+
+func (this *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- this.generated
+	ch <- this.clockRegressions
+	ch <- this.lastRegression
+	ch <- this.counterOverflows
+	ch <- this.entropyErrors
+}
+
+/**
+	Collect implements the prometheus.Collector interface.
+ */
+
+func (this *Collector) Collect(ch chan<- prometheus.Metric) {
+
+	s := this.metrics.Snapshot()
+
+	for v := 1; v <= int(uuid.CustomVer8); v++ {
+		n := s.Generated[fmt.Sprintf("v%d", v)]
+		ch <- prometheus.MustNewConstMetric(this.generated, prometheus.CounterValue, float64(n), strconv.Itoa(v))
+	}
+
+	ch <- prometheus.MustNewConstMetric(this.clockRegressions, prometheus.CounterValue, float64(s.ClockRegressions))
+	ch <- prometheus.MustNewConstMetric(this.lastRegression, prometheus.GaugeValue, time.Duration(s.LastClockRegressionNanos).Seconds())
+	ch <- prometheus.MustNewConstMetric(this.counterOverflows, prometheus.CounterValue, float64(s.CounterOverflows))
+	ch <- prometheus.MustNewConstMetric(this.entropyErrors, prometheus.CounterValue, float64(s.EntropyErrors))
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuidprom_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/codeallergy/uuid/uuidmetrics"
+	"github.com/codeallergy/uuid/uuidprom"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestCollector(t *testing.T) {
+
+	m := uuidmetrics.New()
+	prev := m.Install()
+	defer uuid.SetHooks(prev)
+
+	for i := 0; i < 3; i++ {
+		if _, err := uuid.NewV7(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	c := uuidprom.NewCollector(m)
+	reg := prometheus.NewPedanticRegistry()
+	if err := reg.Register(c); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := testutil.CollectAndCount(c, "uuid_generated_total"); n != int(uuid.CustomVer8) {
+		t.Fatalf("generated series %d", n)
+	}
+
+	expected := `
+# HELP uuid_clock_regressions_total Number of times the wall clock moved backwards.
+# TYPE uuid_clock_regressions_total counter
+uuid_clock_regressions_total 0
+`
+	if err := testutil.GatherAndCompare(reg, bytes.NewBufferString(expected), "uuid_clock_regressions_total"); err != nil {
+		t.Fatal(err)
+	}
+
+	var text bytes.Buffer
+	if err := m.WritePrometheus(&text); err != nil {
+		t.Fatal(err)
+	}
+	if err := testutil.GatherAndCompare(reg, &text); err != nil {
+		t.Fatalf("collector differs from WritePrometheus: %v", err)
+	}
+}
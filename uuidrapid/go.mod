@@ -0,0 +1,12 @@
+module github.com/codeallergy/uuid/uuidrapid
+
+go 1.23
+
+replace github.com/codeallergy/uuid => ../
+
+require (
+	github.com/codeallergy/uuid v0.0.0-00010101000000-000000000000
+	pgregory.net/rapid v1.3.0
+)
+
+require github.com/pkg/errors v0.9.1 // indirect
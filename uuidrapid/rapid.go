@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+/**
+	Generators of UUIDs for pgregory.net/rapid property-based tests
+
+	rapid.Check(t, func(t *rapid.T) {
+		id := uuidrapid.UUID().Draw(t, "id")
+		...
+	})
+
+    Separate module, so the uuid module stays free of the rapid dependency.
+ */
+
+package uuidrapid
+
+import (
+	"math/rand"
+
+	"github.com/codeallergy/uuid"
+	"github.com/codeallergy/uuid/uuidtest"
+	"pgregory.net/rapid"
+)
+
+/**
+	Generates valid IETF variant UUIDs of all versions, shrinks towards version 1 with zero bits
+ */
+
+func UUID() *rapid.Generator[uuid.UUID] {
+	return rapid.Custom(func(t *rapid.T) uuid.UUID {
+		version := rapid.SampledFrom(uuidtest.Versions()).Draw(t, "version")
+		return draw(t, version)
+	})
+}
+
+/**
+	Generates valid IETF variant UUIDs of the version
+ */
+
+func UUIDVersion(version uuid.Version) *rapid.Generator[uuid.UUID] {
+	return rapid.Custom(func(t *rapid.T) uuid.UUID {
+		return draw(t, version)
+	})
+}
+
+/**
+	Generates any 128-bit values, including non-IETF variants and unknown versions
+ */
+
+func Bits() *rapid.Generator[uuid.UUID] {
+	return rapid.Custom(func(t *rapid.T) uuid.UUID {
+		return uuid.UUID{
+			MostSigBits:  rapid.Uint64().Draw(t, "msb"),
+			LeastSigBits: rapid.Uint64().Draw(t, "lsb"),
+		}
+	})
+}
+
+/**
+	Generates strings close to the canonical form that must be rejected by uuid.Parse, see uuidtest.NearValidString
+ */
+
+func NearValidString() *rapid.Generator[string] {
+	return rapid.Custom(func(t *rapid.T) string {
+		seed := rapid.Int64().Draw(t, "seed")
+		return uuidtest.NearValidString(rand.New(rand.NewSource(seed)))
+	})
+}
+
+func draw(t *rapid.T, version uuid.Version) uuid.UUID {
+	msb := rapid.Uint64().Draw(t, "msb")
+	lsb := rapid.Uint64().Draw(t, "lsb")
+	return uuidtest.FromBits(version, msb, lsb)
+}
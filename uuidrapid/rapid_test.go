@@ -0,0 +1,45 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuidrapid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/codeallergy/uuid/uuidrapid"
+	"pgregory.net/rapid"
+)
+
+func TestUUID(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		id := uuidrapid.UUID().Draw(t, "id")
+		if id.Variant() != uuid.IETF {
+			t.Fatalf("variant %v of %v", id.Variant(), id)
+		}
+		parsed, err := uuid.Parse(id.String())
+		if err != nil || parsed != id {
+			t.Fatalf("round trip of %v: %v %v", id, parsed, err)
+		}
+	})
+}
+
+func TestUUIDVersion(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		if id := uuidrapid.UUIDVersion(uuid.TimebasedVer7).Draw(t, "id"); id.Version() != uuid.TimebasedVer7 {
+			t.Fatalf("version %v of %v", id.Version(), id)
+		}
+		uuidrapid.Bits().Draw(t, "bits")
+	})
+}
+
+func TestNearValidString(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := uuidrapid.NearValidString().Draw(t, "s")
+		if _, err := uuid.Parse(s); err == nil {
+			t.Fatalf("near valid string accepted %q", s)
+		}
+	})
+}
@@ -0,0 +1,151 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuidtest
+
+import (
+	"math"
+
+	"github.com/codeallergy/uuid"
+)
+
+/**
+	Bits with the share of ones further than this number of standard deviations from 1/2 are reported as biased
+
+    With 122 random bits the chance of a false alarm for a fair generator is below 1e-3
+ */
+
+const BiasThreshold = 4.5
+
+/**
+	Result of the Analyze run over a sample of UUIDs
+ */
+
+type Report struct {
+
+	/**
+		Number of analyzed UUIDs
+	 */
+
+	Count int
+
+	/**
+		Number of UUIDs by version and by variant
+	 */
+
+	Versions map[uuid.Version]int
+	Variants map[uuid.Variant]int
+
+	/**
+		Number of UUIDs that are not IETF version 4
+	 */
+
+	NonConforming int
+
+	/**
+		Number of ones at every bit position, index 0 is the most significant bit
+	 */
+
+	Ones [128]int
+
+	/**
+		Positions of the 122 random bits of version 4 failing the frequency test
+	 */
+
+	BiasedBits []int
+
+	/**
+		Number of UUIDs seen more than once
+	 */
+
+	Duplicates int
+
+	/**
+		Probability of at least one collision among Count UUIDs with 122 random bits, the birthday bound
+	 */
+
+	CollisionProbability float64
+}
+
+/**
+	Gets share of ones at the bit position, index 0 is the most significant bit
+ */
+
+func (r Report) Frequency(bit int) float64 {
+	if r.Count == 0 {
+		return 0
+	}
+	return float64(r.Ones[bit]) / float64(r.Count)
+}
+
+/**
+	Returns true if all UUIDs are unique IETF version 4 without biased bits
+ */
+
+func (r Report) OK() bool {
+	return r.NonConforming == 0 && r.Duplicates == 0 && len(r.BiasedBits) == 0
+}
+
+/**
+	Runs bit-frequency, version and variant conformity checks and the collision estimate over the sample
+
+    Validates generators that claim to emit version 4 UUIDs, the sample of at least several thousand UUIDs
+    makes the frequency test meaningful
+ */
+
+func Analyze(ids []uuid.UUID) Report {
+
+	r := Report{
+		Count:    len(ids),
+		Versions: make(map[uuid.Version]int),
+		Variants: make(map[uuid.Variant]int),
+	}
+
+	seen := make(map[uuid.UUID]struct{}, len(ids))
+
+	for _, id := range ids {
+
+		version, variant := id.Version(), id.Variant()
+		r.Versions[version]++
+		r.Variants[variant]++
+		if version != uuid.RandomlyGeneratedVer4 || variant != uuid.IETF {
+			r.NonConforming++
+		}
+
+		if _, ok := seen[id]; ok {
+			r.Duplicates++
+		} else {
+			seen[id] = struct{}{}
+		}
+
+		for i := 0; i < 64; i++ {
+			r.Ones[i] += int(id.MostSigBits>>(63-i)) & 1
+			r.Ones[64+i] += int(id.LeastSigBits>>(63-i)) & 1
+		}
+	}
+
+	if r.Count > 0 {
+		n := float64(r.Count)
+		deviation := BiasThreshold * math.Sqrt(n/4)
+		for bit, ones := range r.Ones {
+			if !fixedV4Bit(bit) && math.Abs(float64(ones)-n/2) > deviation {
+				r.BiasedBits = append(r.BiasedBits, bit)
+			}
+		}
+
+		pairs := n * (n - 1) / 2
+		r.CollisionProbability = -math.Expm1(-pairs / math.Exp2(122))
+	}
+
+	return r
+}
+
+/**
+	Version bits 48-51 and variant bits 64-65 are fixed in version 4
+ */
+
+func fixedV4Bit(bit int) bool {
+	return (bit >= 48 && bit < 52) || bit == 64 || bit == 65
+}
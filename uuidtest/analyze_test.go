@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuidtest_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/codeallergy/uuid/uuidtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalyze(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	gen := &uuid.RandomGenerator{Reader: rand.New(rand.NewSource(1))}
+
+	ids := make([]uuid.UUID, 10000)
+	for i := range ids {
+		id, err := gen.Next()
+		assert.NoError(t, err)
+		ids[i] = id
+	}
+
+	report := uuidtest.Analyze(ids)
+	assert.True(t, report.OK(), "%+v", report.BiasedBits)
+	assert.Equal(t, 10000, report.Count)
+	assert.Equal(t, 10000, report.Versions[uuid.RandomlyGeneratedVer4])
+	assert.Equal(t, 10000, report.Variants[uuid.IETF])
+	assert.Equal(t, 1.0, report.Frequency(49))
+	assert.Equal(t, 0.0, report.Frequency(48))
+	assert.InDelta(t, 0.5, report.Frequency(100), 0.05)
+	assert.True(t, report.CollisionProbability > 0 && report.CollisionProbability < 1e-27)
+
+	// vendor SDK with a stuck bit and a repeated ID
+	for i := range ids {
+		ids[i].LeastSigBits &^= 1
+	}
+	ids[1] = ids[0]
+	ids[2] = uuid.New(uuid.TimebasedVer1)
+
+	report = uuidtest.Analyze(ids)
+	assert.False(t, report.OK())
+	assert.Equal(t, []int{127}, report.BiasedBits)
+	assert.Equal(t, 1, report.Duplicates)
+	assert.Equal(t, 1, report.NonConforming)
+
+	assert.True(t, uuidtest.Analyze(nil).OK())
+}
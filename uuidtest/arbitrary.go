@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+/**
+	Testing helpers for code built on the uuid package
+
+    Generators are based on *rand.Rand, the property-based frameworks are supported by the separate modules
+    keeping this one free of their dependencies:
+
+	pgregory.net/rapid:              github.com/codeallergy/uuid/uuidrapid
+	github.com/leanovate/gopter:     github.com/codeallergy/uuid/uuidgopter
+ */
+
+package uuidtest
+
+import (
+	"math/rand"
+	"strings"
+
+	"github.com/codeallergy/uuid"
+)
+
+var versions = []uuid.Version{
+	uuid.TimebasedVer1,
+	uuid.DCESecurityVer2,
+	uuid.NamebasedVer3,
+	uuid.RandomlyGeneratedVer4,
+	uuid.NamebasedVer5,
+	uuid.ReorderedTimebasedVer6,
+	uuid.TimebasedVer7,
+	uuid.CustomVer8,
+}
+
+/**
+	Gets all versions produced by Arbitrary
+ */
+
+func Versions() []uuid.Version {
+	return append([]uuid.Version(nil), versions...)
+}
+
+/**
+	Gets valid IETF variant UUID of the version with the remaining bits taken from msb and lsb
+ */
+
+func FromBits(version uuid.Version, msb, lsb uint64) uuid.UUID {
+	id := uuid.New(version)
+	id.MostSigBits |= msb &^ 0xF000
+	id.LeastSigBits |= lsb & 0x3FFFFFFFFFFFFFFF
+	return id
+}
+
+/**
+	Gets valid IETF variant UUID of the random version
+ */
+
+func Arbitrary(r *rand.Rand) uuid.UUID {
+	return ArbitraryVersion(r, versions[r.Intn(len(versions))])
+}
+
+/**
+	Gets valid IETF variant UUID of the specific version with random remaining bits
+
+    Edge values with all zero or all one payload bits are produced more often than by chance
+ */
+
+func ArbitraryVersion(r *rand.Rand, version uuid.Version) uuid.UUID {
+
+	var msb, lsb uint64
+	switch r.Intn(8) {
+	case 0:
+		msb, lsb = 0, 0
+	case 1:
+		msb, lsb = ^uint64(0), ^uint64(0)
+	default:
+		msb, lsb = r.Uint64(), r.Uint64()
+	}
+
+	return FromBits(version, msb, lsb)
+}
+
+/**
+	Gets any 128-bit value, including non-IETF variants and unknown versions
+ */
+
+func ArbitraryBits(r *rand.Rand) uuid.UUID {
+	return uuid.UUID{MostSigBits: r.Uint64(), LeastSigBits: r.Uint64()}
+}
+
+/**
+	Gets string close to the canonical form of a valid UUID that must be rejected by uuid.Parse
+
+    Mutations: dropped or extra characters, misplaced hyphens, non-hex characters, broken urn prefix,
+    embedded NUL and non-ASCII characters
+ */
+
+func NearValidString(r *rand.Rand) string {
+
+	s := Arbitrary(r).String()
+	pos := r.Intn(len(s))
+
+	switch r.Intn(7) {
+	case 0:
+		return s[:pos] + s[pos+1:]
+	case 1:
+		return s[:pos] + string(s[pos]) + s[pos:]
+	case 2:
+		hyphen := []int{8, 13, 18, 23}[r.Intn(4)]
+		return s[:hyphen] + string(s[hyphen+1]) + "-" + s[hyphen+2:]
+	case 3:
+		for s[pos] == '-' {
+			pos = r.Intn(len(s))
+		}
+		return s[:pos] + string("gGzZ x"[r.Intn(6)]) + s[pos+1:]
+	case 4:
+		return "urn:uuld:" + s
+	case 5:
+		for s[pos] == '-' {
+			pos = r.Intn(len(s))
+		}
+		return s[:pos] + "\x00" + s[pos+1:]
+	default:
+		for s[pos] == '-' {
+			pos = r.Intn(len(s))
+		}
+		return strings.Replace(s, s[pos:pos+1], "é", 1)[:len(s)]
+	}
+}
@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuidtest_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/codeallergy/uuid/uuidtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArbitrary(t *testing.T) {
+
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 10000; i++ {
+
+		id := uuidtest.Arbitrary(r)
+		assert.Equal(t, uuid.IETF, id.Variant())
+		assert.NotEqual(t, uuid.BadVersion, id.Version())
+
+		id = uuidtest.ArbitraryVersion(r, uuid.TimebasedVer7)
+		assert.Equal(t, uuid.TimebasedVer7, id.Version())
+
+		s := uuidtest.NearValidString(r)
+		if _, err := uuid.Parse(s); err == nil {
+			t.Fatal("near valid string accepted ", s)
+		}
+	}
+
+	a := uuidtest.ArbitraryBits(rand.New(rand.NewSource(7)))
+	b := uuidtest.ArbitraryBits(rand.New(rand.NewSource(7)))
+	assert.Equal(t, a, b)
+
+}
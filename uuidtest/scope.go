@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuidtest
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+)
+
+/**
+	Namespace of the test scopes
+ */
+
+var ScopeNamespace = uuid.NewSHA1(uuid.NamespaceURL, []byte("https://github.com/codeallergy/uuid/uuidtest"))
+
+type scopeGenerator struct {
+	sync.Mutex
+	scope   uuid.UUID
+	counter int
+}
+
+/**
+	Gets generator of the IDs derived from the test name, version 5 of the counter in the namespace of the test
+
+    Parallel tests and subtests have distinct names, so their IDs do not collide, and the same test
+    gets the same sequence on every run and machine, so golden files stay stable.
+ */
+
+func TestScope(t testing.TB) uuid.Generator {
+	return &scopeGenerator{scope: scopeOf(t)}
+}
+
+/**
+	Gets the n-th ID of the sequence of TestScope starting from 0, e.g. for expectations
+ */
+
+func ScopedID(t testing.TB, n int) uuid.UUID {
+	return uuid.NewSHA1(scopeOf(t), []byte(strconv.Itoa(n)))
+}
+
+func scopeOf(t testing.TB) uuid.UUID {
+	return uuid.NewSHA1(ScopeNamespace, []byte(t.Name()))
+}
+
+func (this *scopeGenerator) Next() (uuid.UUID, error) {
+	this.Lock()
+	defer this.Unlock()
+	id := uuid.NewSHA1(this.scope, []byte(strconv.Itoa(this.counter)))
+	this.counter++
+	return id, nil
+}
@@ -0,0 +1,42 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuidtest_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/codeallergy/uuid/uuidtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTestScope(t *testing.T) {
+
+	g := uuidtest.TestScope(t)
+	first, err := g.Next()
+	assert.NoError(t, err)
+	second, err := g.Next()
+	assert.NoError(t, err)
+
+	assert.NotEqual(t, first, second)
+	assert.Equal(t, uuid.NamebasedVer5, first.Version())
+	assert.Equal(t, uuidtest.ScopedID(t, 0), first)
+	assert.Equal(t, uuidtest.ScopedID(t, 1), second)
+
+	again, _ := uuidtest.TestScope(t).Next()
+	assert.Equal(t, first, again)
+
+	assert.Equal(t, uuid.MustParse("f63f6b88-6489-5a19-9514-6d7bcf9eb092"), first)
+
+	var sub uuid.UUID
+	t.Run("sub", func(t *testing.T) {
+		t.Parallel()
+		sub, _ = uuidtest.TestScope(t).Next()
+	})
+	t.Cleanup(func() {
+		assert.NotEqual(t, first, sub)
+	})
+}
@@ -0,0 +1,153 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuidtest
+
+import (
+	"sync"
+	"time"
+
+	"github.com/codeallergy/uuid"
+)
+
+/**
+	Result of the StressTest run
+ */
+
+type StressReport struct {
+
+	/**
+		Number of UUIDs returned without error by all workers
+	 */
+
+	Generated int
+
+	/**
+		Number of UUIDs returned more than once
+	 */
+
+	Duplicates int
+
+	/**
+		Number of Time-based UUIDs that were not strictly greater than the previous one of the same worker
+	 */
+
+	OrderViolations int
+
+	/**
+		Number of failed Next calls and the first error
+	 */
+
+	Errors     int
+	FirstError error
+
+	Elapsed time.Duration
+}
+
+/**
+	Gets number of generated UUIDs per second
+ */
+
+func (r StressReport) Throughput() float64 {
+	if r.Elapsed <= 0 {
+		return 0
+	}
+	return float64(r.Generated) / r.Elapsed.Seconds()
+}
+
+/**
+	Gets true if no duplicates, ordering violations or errors were found
+ */
+
+func (r StressReport) OK() bool {
+	return r.Duplicates == 0 && r.OrderViolations == 0 && r.Errors == 0
+}
+
+/**
+	Calls generator from the number of concurrent workers for the duration and checks the results
+
+    Every worker keeps all UUIDs it received, so memory grows with throughput, keep the duration short in CI.
+    Ordering is checked only between consecutive Time-based UUIDs (versions 1, 6 and 7) of the same worker,
+    because calls of different workers are not ordered with each other.
+ */
+
+func StressTest(g uuid.Generator, workers int, duration time.Duration) StressReport {
+
+	if workers < 1 {
+		workers = 1
+	}
+
+	type result struct {
+		ids        []uuid.UUID
+		violations int
+		errors     int
+		firstError error
+	}
+
+	results := make([]result, workers)
+	deadline := time.Now().Add(duration)
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(res *result) {
+			defer wg.Done()
+			var prev uuid.UUID
+			for i := 0; ; i++ {
+				if i&0xFF == 0 && !time.Now().Before(deadline) {
+					return
+				}
+				id, err := g.Next()
+				if err != nil {
+					if res.errors == 0 {
+						res.firstError = err
+					}
+					res.errors++
+					continue
+				}
+				if len(res.ids) > 0 && timeBased(prev) && timeBased(id) && uuid.CompareTimeFirst(prev, id) >= 0 {
+					res.violations++
+				}
+				res.ids = append(res.ids, id)
+				prev = id
+			}
+		}(&results[w])
+	}
+	wg.Wait()
+
+	report := StressReport{Elapsed: time.Since(start)}
+
+	for _, res := range results {
+		report.Generated += len(res.ids)
+	}
+
+	seen := make(map[uuid.UUID]struct{}, report.Generated)
+	for _, res := range results {
+		for _, id := range res.ids {
+			if _, ok := seen[id]; ok {
+				report.Duplicates++
+			} else {
+				seen[id] = struct{}{}
+			}
+		}
+		report.OrderViolations += res.violations
+		report.Errors += res.errors
+		if report.FirstError == nil {
+			report.FirstError = res.firstError
+		}
+	}
+
+	return report
+}
+
+func timeBased(id uuid.UUID) bool {
+	switch id.Version() {
+	case uuid.TimebasedVer1, uuid.ReorderedTimebasedVer6, uuid.TimebasedVer7:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuidtest_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/codeallergy/uuid/uuidtest"
+	"github.com/stretchr/testify/assert"
+)
+
+type repeatingGenerator struct {
+	sync.Mutex
+	n int
+}
+
+func (g *repeatingGenerator) Next() (uuid.UUID, error) {
+	g.Lock()
+	defer g.Unlock()
+	g.n++
+	if g.n%100 == 0 {
+		return uuid.Empty, errors.New("unavailable")
+	}
+	id := uuid.New(uuid.TimebasedVer1)
+	id.SetTime100Nanos(int64(1000 - g.n%10))
+	return id, nil
+}
+
+func TestStressTest(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	for _, version := range []uuid.Version{uuid.TimebasedVer1, uuid.RandomlyGeneratedVer4, uuid.TimebasedVer7} {
+		gen, err := uuid.NewGenerator(version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		report := uuidtest.StressTest(gen, 4, 50*time.Millisecond)
+		assert.True(t, report.OK(), "%v %+v", version, report)
+		assert.True(t, report.Generated > 0)
+		assert.True(t, report.Throughput() > 0)
+	}
+
+	report := uuidtest.StressTest(&repeatingGenerator{}, 2, 20*time.Millisecond)
+	assert.False(t, report.OK())
+	assert.True(t, report.Duplicates > 0)
+	assert.True(t, report.OrderViolations > 0)
+	assert.True(t, report.Errors > 0)
+	assert.EqualError(t, report.FirstError, "unavailable")
+
+}
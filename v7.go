@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import "sync"
+
+/**
+	Layout of version 7 UUID defined in RFC 9562
+
+	msb: 48-bit unix_ts_ms + 4-bit version + 12-bit rand_a
+	lsb: 2-bit variant + 62-bit rand_b
+ */
+
+const (
+	v7VersionBits = uint64(0x0000000000007000)
+	v7CounterMask = uint64(0x0000000000000FFF)
+)
+
+var (
+	defaultV7Once      sync.Once
+	defaultV7Generator *TimeGenerator
+	defaultV7Error     error
+)
+
+/**
+	Generates version 7 UUID ordered by unix time in milliseconds
+
+    UUIDs generated by the same process are strictly increasing
+ */
+
+func NewV7() (UUID, error) {
+	gen, err := defaultTimeGenerator(TimebasedVer7)
+	if err != nil {
+		return Empty, err
+	}
+	return gen.Next()
+}
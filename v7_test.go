@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewV7(t *testing.T) {
+
+	if uuid.Frozen() {
+		t.Skip("uuid_frozen build does not mint IDs")
+	}
+
+	before := time.Now().UnixNano() / int64(time.Millisecond)
+
+	prev, err := uuid.NewV7()
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.TimebasedVer7, prev.Version())
+	assert.Equal(t, uuid.IETF, prev.Variant())
+	assert.True(t, prev.UnixTimeMillis() >= before)
+
+	prevBin, _ := prev.MarshalBinary()
+
+	for i := 0; i < 10000; i++ {
+		id, err := uuid.NewV7()
+		assert.NoError(t, err)
+		bin, _ := id.MarshalBinary()
+		if bytes.Compare(prevBin, bin) >= 0 {
+			t.Fatal("not monotonic ", prev, id)
+		}
+		prev, prevBin = id, bin
+	}
+
+}
+
+/**
+	Test vector of RFC 9562 appendix A.6
+ */
+
+func TestV7TestVector(t *testing.T) {
+
+	millis := int64(1645557742000)
+
+	id, err := uuid.Parse("017F22E2-79B0-7CC3-98C4-DC0C0C07398F")
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.TimebasedVer7, id.Version())
+	assert.Equal(t, uuid.IETF, id.Variant())
+	assert.Equal(t, millis, id.UnixTimeMillis())
+	assert.Equal(t, millis*10000, id.UnixTime100Nanos())
+	assert.Equal(t, time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC), id.Time().UTC())
+
+	built := uuid.New(uuid.TimebasedVer7)
+	built.SetUnixTimeMillis(millis)
+	built.MostSigBits |= 0xCC3
+	built.LeastSigBits = 0x98C4DC0C0C07398F
+	assert.Equal(t, id, built)
+
+	moved := id
+	moved.SetTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, int64(1672531200000), moved.UnixTimeMillis())
+	assert.Equal(t, uuid.TimebasedVer7, moved.Version())
+	assert.Equal(t, id.MostSigBits&0xFFFF, moved.MostSigBits&0xFFFF)
+	assert.Equal(t, id.LeastSigBits, moved.LeastSigBits)
+
+	moved.SetUnixTime100Nanos(millis * 10000)
+	assert.Equal(t, id, moved)
+}
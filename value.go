@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Hashes Go value into the deterministic version 8 UUID, e.g. content-addressable cache key of the request struct
+
+    Same as FromValueIn with the Nil namespace
+ */
+
+func FromValue(v interface{}) (UUID, error) {
+	return FromValueIn(Empty, v)
+}
+
+/**
+	Hashes Go value in the namespace into the deterministic version 8 UUID, SHA-256 like DeriveV8
+
+    The canonical encoding is JSON: map keys are sorted, struct fields follow the declaration order
+    and json tags, unexported fields are ignored. Equal values give equal UUIDs across processes and releases
+    as long as the types keep their name and JSON form. Channels, functions and NaN can not be hashed.
+
+    The type is hashed before the JSON, so values of different types with the same JSON form
+    give different UUIDs, a pointer gives the same UUID as the value it points to.
+    Named types are identified by the import path and the name, e.g. "github.com/acme/api.SearchRequest",
+    unnamed and predeclared types by their Go syntax, e.g. "map[string]int". Moving or renaming the type
+    changes the UUIDs, so types of persisted keys stay in place or the change goes with a new namespace.
+ */
+
+func FromValueIn(namespace UUID, v interface{}) (UUID, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return Empty, errors.Wrap(err, "canonical encoding")
+	}
+	var name string
+	if t := reflect.TypeOf(v); t != nil {
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		name = typeName(t)
+	}
+	content := make([]byte, 0, len(name)+1+len(data))
+	content = append(append(append(content, name...), 0), data...)
+	return DeriveV8(namespace, content), nil
+}
+
+/**
+	Gets the import path and the name of the named type, independent of the package name used by the caller
+ */
+
+func typeName(t reflect.Type) string {
+	if t.Name() == "" || t.PkgPath() == "" {
+		return t.String()
+	}
+	return t.PkgPath() + "." + t.Name()
+}
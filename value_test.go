@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type searchRequest struct {
+	Query  string            `json:"query"`
+	Limit  int               `json:"limit"`
+	Labels map[string]string `json:"labels,omitempty"`
+	cursor string
+}
+
+func TestFromValue(t *testing.T) {
+
+	a := searchRequest{Query: "uuid", Limit: 10, Labels: map[string]string{"b": "2", "a": "1"}, cursor: "x"}
+	b := searchRequest{Query: "uuid", Limit: 10, Labels: map[string]string{"a": "1", "b": "2"}}
+
+	ida, err := uuid.FromValue(a)
+	assert.NoError(t, err)
+	idb, err := uuid.FromValue(&b)
+	assert.NoError(t, err)
+	assert.Equal(t, ida, idb)
+	assert.Equal(t, uuid.CustomVer8, ida.Version())
+	assert.Equal(t, uuid.DeriveV8(uuid.Empty, []byte("github.com/codeallergy/uuid_test.searchRequest\x00"+`{"query":"uuid","limit":10,"labels":{"a":"1","b":"2"}}`)), ida)
+
+	type otherRequest searchRequest
+	ido, err := uuid.FromValue(otherRequest(b))
+	assert.NoError(t, err)
+	assert.NotEqual(t, ida, ido)
+
+	b.Limit = 11
+	idb, _ = uuid.FromValue(b)
+	assert.NotEqual(t, ida, idb)
+
+	ids, err := uuid.FromValue(map[string]int{"a": 1})
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.DeriveV8(uuid.Empty, []byte("map[string]int\x00"+`{"a":1}`)), ids)
+
+	scoped, err := uuid.FromValueIn(uuid.NamespaceURL, a)
+	assert.NoError(t, err)
+	assert.NotEqual(t, ida, scoped)
+
+	_, err = uuid.FromValue(math.NaN())
+	assert.Error(t, err)
+	_, err = uuid.FromValue(func() {})
+	assert.Error(t, err)
+}
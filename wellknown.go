@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid
+
+import (
+	"sync"
+
+	"github.com/codeallergy/uuid/internal/errors"
+)
+
+/**
+	Max UUID of RFC 9562 section 5.10 with all bits set
+ */
+
+var Max = UUID{MostSigBits: ^uint64(0), LeastSigBits: ^uint64(0)}
+
+/**
+	Human-readable names of well-known UUIDs shown by Inspect and the CLI
+ */
+
+var wellKnown = struct {
+	sync.RWMutex
+	names map[UUID]string
+}{
+	names: map[UUID]string{
+		Empty:         "Nil",
+		Max:           "Max",
+		NamespaceDNS:  "NamespaceDNS",
+		NamespaceURL:  "NamespaceURL",
+		NamespaceOID:  "NamespaceOID",
+		NamespaceX500: "NamespaceX500",
+	},
+}
+
+/**
+	Registers the name of the application constant, so debugging output shows the name instead of hex
+
+    Fails if the UUID is already registered with another name
+ */
+
+func RegisterName(id UUID, name string) error {
+	if name == "" {
+		return errors.New("empty well-known name")
+	}
+	wellKnown.Lock()
+	defer wellKnown.Unlock()
+	if existing, ok := wellKnown.names[id]; ok && existing != name {
+		return errors.Errorf("UUID %v is already registered as %q", id, existing)
+	}
+	wellKnown.names[id] = name
+	return nil
+}
+
+/**
+	Removes the name of the UUID
+ */
+
+func UnregisterName(id UUID) {
+	wellKnown.Lock()
+	defer wellKnown.Unlock()
+	delete(wellKnown.names, id)
+}
+
+/**
+	Gets the name of the well-known UUID
+ */
+
+func NameOf(id UUID) (string, bool) {
+	wellKnown.RLock()
+	defer wellKnown.RUnlock()
+	name, ok := wellKnown.names[id]
+	return name, ok
+}
+
+/**
+	Gets copy of all well-known names
+ */
+
+func WellKnown() map[UUID]string {
+	wellKnown.RLock()
+	defer wellKnown.RUnlock()
+	names := make(map[UUID]string, len(wellKnown.names))
+	for id, name := range wellKnown.names {
+		names[id] = name
+	}
+	return names
+}
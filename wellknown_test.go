@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2023 Zander Schwid & Co. LLC.
+ * SPDX-License-Identifier: BUSL-1.1
+ */
+
+package uuid_test
+
+import (
+	"testing"
+
+	"github.com/codeallergy/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWellKnown(t *testing.T) {
+
+	name, ok := uuid.NameOf(uuid.NamespaceDNS)
+	assert.True(t, ok)
+	assert.Equal(t, "NamespaceDNS", name)
+	assert.Equal(t, "NamespaceDNS", uuid.NamespaceDNS.Inspect().Name)
+	assert.Equal(t, "Max", uuid.MustParse("ffffffff-ffff-ffff-ffff-ffffffffffff").Inspect().Name)
+	assert.Equal(t, "Nil", uuid.Empty.Inspect().Name)
+
+	tenant := uuid.MustParse("534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+	_, ok = uuid.NameOf(tenant)
+	assert.False(t, ok)
+	assert.Empty(t, tenant.Inspect().Name)
+
+	assert.NoError(t, uuid.RegisterName(tenant, "SystemTenant"))
+	defer uuid.UnregisterName(tenant)
+	assert.NoError(t, uuid.RegisterName(tenant, "SystemTenant"))
+	assert.Error(t, uuid.RegisterName(tenant, "OtherTenant"))
+	assert.Error(t, uuid.RegisterName(uuid.New(uuid.CustomVer8), ""))
+
+	assert.Equal(t, "SystemTenant", tenant.Inspect().Name)
+	assert.Equal(t, "SystemTenant", uuid.WellKnown()[tenant])
+
+	uuid.UnregisterName(tenant)
+	_, ok = uuid.NameOf(tenant)
+	assert.False(t, ok)
+}